@@ -0,0 +1,339 @@
+package corporate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/request"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/repository"
+	"github.com/taco-labs/taco/go/utils"
+	"github.com/uptrace/bun"
+)
+
+type corporateApp struct {
+	app.Transactor
+	repository struct {
+		corporate repository.CorporateRepository
+	}
+}
+
+func (c corporateApp) CreateCorporate(ctx context.Context, req request.CreateCorporateRequest) (entity.Corporate, error) {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	corporate := entity.Corporate{
+		Id:           utils.MustNewUUID(),
+		Name:         req.Name,
+		BillingEmail: req.BillingEmail,
+		CreateTime:   requestTime,
+		UpdateTime:   requestTime,
+	}
+
+	err := c.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		if err := c.repository.corporate.Create(ctx, i, corporate); err != nil {
+			return fmt.Errorf("app.corporate.CreateCorporate: error while create corporate: %w", err)
+		}
+
+		policy := entity.NewDefaultCorporatePolicy(corporate.Id, requestTime)
+		if err := c.repository.corporate.UpsertPolicy(ctx, i, policy); err != nil {
+			return fmt.Errorf("app.corporate.CreateCorporate: error while create default policy: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return entity.Corporate{}, err
+	}
+
+	return corporate, nil
+}
+
+func (c corporateApp) GetCorporate(ctx context.Context, corporateId string) (entity.Corporate, error) {
+	var corporate entity.Corporate
+	var err error
+
+	err = c.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		corporate, err = c.repository.corporate.GetById(ctx, i, corporateId)
+		if err != nil {
+			return fmt.Errorf("app.corporate.GetCorporate: error while get corporate: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return entity.Corporate{}, err
+	}
+
+	return corporate, nil
+}
+
+func (c corporateApp) ListUserCorporate(ctx context.Context, userId string) ([]entity.Corporate, error) {
+	var corporates []entity.Corporate
+	var err error
+
+	err = c.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		corporates, err = c.repository.corporate.ListByUserId(ctx, i, userId)
+		if err != nil {
+			return fmt.Errorf("app.corporate.ListUserCorporate: error while list corporates: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return []entity.Corporate{}, err
+	}
+
+	return corporates, nil
+}
+
+func (c corporateApp) ListMembers(ctx context.Context, corporateId string) ([]entity.CorporateMember, error) {
+	var members []entity.CorporateMember
+	var err error
+
+	err = c.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		members, err = c.repository.corporate.ListMembers(ctx, i, corporateId)
+		if err != nil {
+			return fmt.Errorf("app.corporate.ListMembers: error while list members: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return []entity.CorporateMember{}, err
+	}
+
+	return members, nil
+}
+
+func (c corporateApp) AddMember(ctx context.Context, corporateId string, userId string) error {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	return c.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		if _, err := c.repository.corporate.GetById(ctx, i, corporateId); err != nil {
+			return fmt.Errorf("app.corporate.AddMember: error while get corporate: %w", err)
+		}
+
+		_, err := c.repository.corporate.GetMember(ctx, i, corporateId, userId)
+		if err != nil && !errors.Is(err, value.ErrNotFound) {
+			return fmt.Errorf("app.corporate.AddMember: error while get member: %w", err)
+		}
+		if err == nil {
+			return fmt.Errorf("app.corporate.AddMember: member already exists: %w", value.ErrAlreadyExists)
+		}
+
+		member := entity.CorporateMember{
+			CorporateId: corporateId,
+			UserId:      userId,
+			CreateTime:  requestTime,
+		}
+		if err := c.repository.corporate.CreateMember(ctx, i, member); err != nil {
+			return fmt.Errorf("app.corporate.AddMember: error while create member: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (c corporateApp) RemoveMember(ctx context.Context, corporateId string, userId string) error {
+	return c.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		member, err := c.repository.corporate.GetMember(ctx, i, corporateId, userId)
+		if err != nil {
+			return fmt.Errorf("app.corporate.RemoveMember: error while get member: %w", err)
+		}
+
+		if err := c.repository.corporate.DeleteMember(ctx, i, member); err != nil {
+			return fmt.Errorf("app.corporate.RemoveMember: error while delete member: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (c corporateApp) GetPolicy(ctx context.Context, corporateId string) (entity.CorporatePolicy, error) {
+	var policy entity.CorporatePolicy
+	var err error
+
+	err = c.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		policy, err = c.repository.corporate.GetPolicy(ctx, i, corporateId)
+		if err != nil {
+			return fmt.Errorf("app.corporate.GetPolicy: error while get policy: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return entity.CorporatePolicy{}, err
+	}
+
+	return policy, nil
+}
+
+func (c corporateApp) UpdatePolicy(ctx context.Context, req request.UpdateCorporatePolicyRequest) (entity.CorporatePolicy, error) {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	if err := req.Validate(); err != nil {
+		return entity.CorporatePolicy{}, fmt.Errorf("app.corporate.UpdatePolicy: invalid request: %w", err)
+	}
+
+	policy := entity.CorporatePolicy{
+		CorporateId:     req.CorporateId,
+		StartHour:       req.StartHour,
+		EndHour:         req.EndHour,
+		Regions:         req.Regions,
+		MaxPricePerRide: req.MaxPricePerRide,
+		UpdateTime:      requestTime,
+	}
+	if policy.Regions == nil {
+		policy.Regions = []string{}
+	}
+
+	err := c.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		if _, err := c.repository.corporate.GetById(ctx, i, req.CorporateId); err != nil {
+			return fmt.Errorf("app.corporate.UpdatePolicy: error while get corporate: %w", err)
+		}
+
+		if err := c.repository.corporate.UpsertPolicy(ctx, i, policy); err != nil {
+			return fmt.Errorf("app.corporate.UpdatePolicy: error while upsert policy: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return entity.CorporatePolicy{}, err
+	}
+
+	return policy, nil
+}
+
+// ApplyPolicy checks the user can take the taxi call billed to corporate under its spending policy.
+// Returns taxi call request with corporate billing information and max additional price limited by per-ride cap.
+func (c corporateApp) ApplyPolicy(ctx context.Context, corporateId string, taxiCallRequest entity.TaxiCallRequest) (entity.TaxiCallRequest, error) {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	err := c.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		corporate, err := c.repository.corporate.GetById(ctx, i, corporateId)
+		if err != nil {
+			return fmt.Errorf("app.corporate.ApplyPolicy: error while get corporate: %w", err)
+		}
+
+		_, err = c.repository.corporate.GetMember(ctx, i, corporateId, taxiCallRequest.UserId)
+		if errors.Is(err, value.ErrNotFound) {
+			return fmt.Errorf("app.corporate.ApplyPolicy: not a member of corporate: %w", value.ErrUnAuthorized)
+		}
+		if err != nil {
+			return fmt.Errorf("app.corporate.ApplyPolicy: error while get member: %w", err)
+		}
+
+		policy, err := c.repository.corporate.GetPolicy(ctx, i, corporateId)
+		if err != nil {
+			return fmt.Errorf("app.corporate.ApplyPolicy: error while get policy: %w", err)
+		}
+
+		if !policy.AvailableTime(requestTime) {
+			return fmt.Errorf("app.corporate.ApplyPolicy: not allowed time: %w", value.ErrCorporatePolicyViolation)
+		}
+
+		if !policy.AvailableRegion(taxiCallRequest.Departure.Address, taxiCallRequest.Arrival.Address) {
+			return fmt.Errorf("app.corporate.ApplyPolicy: not allowed region: %w", value.ErrCorporatePolicyViolation)
+		}
+
+		if !policy.AvailablePrice(taxiCallRequest.RequestBasePrice) {
+			return fmt.Errorf("app.corporate.ApplyPolicy: per-ride cap exceeded: %w", value.ErrCorporatePolicyViolation)
+		}
+
+		if policy.MaxPricePerRide > 0 {
+			maxAdditionalPrice := policy.MaxPricePerRide - taxiCallRequest.RequestBasePrice
+			if taxiCallRequest.RequestMaxAdditionalPrice > maxAdditionalPrice {
+				taxiCallRequest.RequestMaxAdditionalPrice = maxAdditionalPrice
+			}
+		}
+
+		taxiCallRequest.CorporateId = sql.NullString{
+			Valid:  true,
+			String: corporate.Id,
+		}
+		taxiCallRequest.PaymentSummary = value.PaymentSummary{
+			Company: corporate.Name,
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return entity.TaxiCallRequest{}, err
+	}
+
+	return taxiCallRequest, nil
+}
+
+func (c corporateApp) ListInvoices(ctx context.Context, corporateId string) ([]entity.CorporateInvoice, error) {
+	var invoices []entity.CorporateInvoice
+	var err error
+
+	err = c.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		invoices, err = c.repository.corporate.ListInvoices(ctx, i, corporateId)
+		if err != nil {
+			return fmt.Errorf("app.corporate.ListInvoices: error while list invoices: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return []entity.CorporateInvoice{}, err
+	}
+
+	return invoices, nil
+}
+
+// GenerateInvoice aggregates completed taxi calls billed to corporate within the billing month (eg. 2022-10)
+func (c corporateApp) GenerateInvoice(ctx context.Context, corporateId string, billingMonth string) (entity.CorporateInvoice, error) {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	start, end, err := entity.CorporateBillingPeriod(billingMonth)
+	if err != nil {
+		return entity.CorporateInvoice{}, fmt.Errorf("app.corporate.GenerateInvoice: %w", err)
+	}
+
+	if requestTime.Before(end) {
+		return entity.CorporateInvoice{}, fmt.Errorf("app.corporate.GenerateInvoice: billing month not yet closed: %w", value.ErrInvalidOperation)
+	}
+
+	var invoice entity.CorporateInvoice
+
+	err = c.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		if _, err := c.repository.corporate.GetById(ctx, i, corporateId); err != nil {
+			return fmt.Errorf("app.corporate.GenerateInvoice: error while get corporate: %w", err)
+		}
+
+		rideCount, totalPrice, err := c.repository.corporate.SummarizeRides(ctx, i, corporateId, start, end)
+		if err != nil {
+			return fmt.Errorf("app.corporate.GenerateInvoice: error while summarize rides: %w", err)
+		}
+
+		invoice = entity.CorporateInvoice{
+			Id:           utils.MustNewUUID(),
+			CorporateId:  corporateId,
+			BillingMonth: billingMonth,
+			RideCount:    rideCount,
+			TotalPrice:   totalPrice,
+			CreateTime:   requestTime,
+		}
+		if err := c.repository.corporate.CreateInvoice(ctx, i, invoice); err != nil {
+			return fmt.Errorf("app.corporate.GenerateInvoice: error while create invoice: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return entity.CorporateInvoice{}, err
+	}
+
+	return invoice, nil
+}
@@ -0,0 +1,44 @@
+package corporate
+
+import (
+	"errors"
+
+	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/repository"
+)
+
+type corporateAppOption func(*corporateApp)
+
+func WithTransactor(transactor app.Transactor) corporateAppOption {
+	return func(ca *corporateApp) {
+		ca.Transactor = transactor
+	}
+}
+
+func WithCorporateRepository(repo repository.CorporateRepository) corporateAppOption {
+	return func(ca *corporateApp) {
+		ca.repository.corporate = repo
+	}
+}
+
+func (c corporateApp) validateApp() error {
+	if c.Transactor == nil {
+		return errors.New("corporate app need transactor")
+	}
+
+	if c.repository.corporate == nil {
+		return errors.New("corporate app need corporate repository")
+	}
+
+	return nil
+}
+
+func NewCorporateApp(opts ...corporateAppOption) (corporateApp, error) {
+	app := corporateApp{}
+
+	for _, opt := range opts {
+		opt(&app)
+	}
+
+	return app, app.validateApp()
+}
@@ -0,0 +1,284 @@
+package coupon
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/request"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/taco-labs/taco/go/repository"
+	"github.com/taco-labs/taco/go/utils"
+	"github.com/uptrace/bun"
+)
+
+type couponApp struct {
+	app.Transactor
+	repository struct {
+		coupon repository.CouponRepository
+	}
+}
+
+func (c couponApp) CreateCoupon(ctx context.Context, req request.CreateCouponRequest) (entity.Coupon, error) {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	if err := req.Validate(); err != nil {
+		return entity.Coupon{}, fmt.Errorf("app.coupon.CreateCoupon: invalid request: %w", err)
+	}
+
+	coupon := entity.Coupon{
+		Id:               utils.MustNewUUID(),
+		Code:             req.Code,
+		Name:             req.Name,
+		DiscountType:     enum.CouponDiscountTypeFromString(req.DiscountType),
+		DiscountAmount:   req.DiscountAmount,
+		MaxDiscountPrice: req.MaxDiscountPrice,
+		UsageLimit:       req.UsageLimit,
+		UsageCount:       0,
+		PerUserLimit:     req.PerUserLimit,
+		UserId: sql.NullString{
+			Valid:  req.UserId != "",
+			String: req.UserId,
+		},
+		Regions:    req.Regions,
+		StartTime:  req.StartTime,
+		ExpireTime: req.ExpireTime,
+		CreateTime: requestTime,
+		UpdateTime: requestTime,
+	}
+	if coupon.Regions == nil {
+		coupon.Regions = []string{}
+	}
+
+	err := c.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		_, err := c.repository.coupon.GetByCode(ctx, i, req.Code)
+		if err != nil && !errors.Is(err, value.ErrCouponNotFound) {
+			return fmt.Errorf("app.coupon.CreateCoupon: error while get coupon by code: %w", err)
+		}
+		if err == nil {
+			return fmt.Errorf("app.coupon.CreateCoupon: coupon code already exists: %w", value.ErrAlreadyExists)
+		}
+
+		if err := c.repository.coupon.Create(ctx, i, coupon); err != nil {
+			return fmt.Errorf("app.coupon.CreateCoupon: error while create coupon: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return entity.Coupon{}, err
+	}
+
+	return coupon, nil
+}
+
+func (c couponApp) GetCoupon(ctx context.Context, couponId string) (entity.Coupon, error) {
+	var coupon entity.Coupon
+	var err error
+
+	err = c.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		coupon, err = c.repository.coupon.GetById(ctx, i, couponId)
+		if err != nil {
+			return fmt.Errorf("app.coupon.GetCoupon: error while get coupon: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return entity.Coupon{}, err
+	}
+
+	return coupon, nil
+}
+
+func (c couponApp) ListUserCoupon(ctx context.Context, userId string) ([]entity.Coupon, error) {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	var coupons []entity.Coupon
+	var err error
+
+	err = c.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		coupons, err = c.repository.coupon.ListByUserId(ctx, i, userId, requestTime)
+		if err != nil {
+			return fmt.Errorf("app.coupon.ListUserCoupon: error while list coupons: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return []entity.Coupon{}, err
+	}
+
+	return coupons, nil
+}
+
+// GetDiscountPrice validates the coupon for the taxi call request and returns expected discount price without reserving it
+func (c couponApp) GetDiscountPrice(ctx context.Context, couponCode string, taxiCallRequest entity.TaxiCallRequest) (int, error) {
+	var discountPrice int
+
+	err := c.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		coupon, err := c.getAvailableCoupon(ctx, i, couponCode, taxiCallRequest)
+		if err != nil {
+			return fmt.Errorf("app.coupon.GetDiscountPrice: %w", err)
+		}
+
+		discountPrice = coupon.GetDiscountPrice(taxiCallRequest.RequestBasePrice)
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return discountPrice, nil
+}
+
+// ReserveCoupon validates the coupon and reserves it for the taxi call request
+func (c couponApp) ReserveCoupon(ctx context.Context, couponCode string, taxiCallRequest entity.TaxiCallRequest) (int, error) {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+	var discountPrice int
+
+	err := c.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		coupon, err := c.getAvailableCoupon(ctx, i, couponCode, taxiCallRequest)
+		if err != nil {
+			return fmt.Errorf("app.coupon.ReserveCoupon: %w", err)
+		}
+
+		if err := c.repository.coupon.IncreaseUsageCount(ctx, i, coupon.Id); err != nil {
+			return fmt.Errorf("app.coupon.ReserveCoupon: error while increase usage count: %w", err)
+		}
+
+		discountPrice = coupon.GetDiscountPrice(taxiCallRequest.RequestBasePrice)
+
+		couponUsage := entity.CouponUsage{
+			TaxiCallRequestId: taxiCallRequest.Id,
+			CouponId:          coupon.Id,
+			UserId:            taxiCallRequest.UserId,
+			State:             enum.CouponUsageState_RESERVED,
+			DiscountPrice:     discountPrice,
+			CreateTime:        requestTime,
+			UpdateTime:        requestTime,
+		}
+		if err := c.repository.coupon.CreateUsage(ctx, i, couponUsage); err != nil {
+			return fmt.Errorf("app.coupon.ReserveCoupon: error while create coupon usage: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return discountPrice, nil
+}
+
+// UseCoupon consumes the reserved coupon of the taxi call request with its final price.
+// Returns zero discount price if no coupon is reserved for the taxi call request.
+func (c couponApp) UseCoupon(ctx context.Context, taxiCallRequest entity.TaxiCallRequest) (int, error) {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+	var discountPrice int
+
+	err := c.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		couponUsage, err := c.repository.coupon.GetUsage(ctx, i, taxiCallRequest.Id)
+		if errors.Is(err, value.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("app.coupon.UseCoupon: error while get coupon usage: %w", err)
+		}
+		if couponUsage.State != enum.CouponUsageState_RESERVED {
+			return fmt.Errorf("app.coupon.UseCoupon: coupon usage is not reserved: %w", value.ErrInvalidOperation)
+		}
+
+		coupon, err := c.repository.coupon.GetById(ctx, i, couponUsage.CouponId)
+		if err != nil {
+			return fmt.Errorf("app.coupon.UseCoupon: error while get coupon: %w", err)
+		}
+
+		discountPrice = coupon.GetDiscountPrice(taxiCallRequest.BasePrice + taxiCallRequest.AdditionalPrice)
+
+		couponUsage.State = enum.CouponUsageState_USED
+		couponUsage.DiscountPrice = discountPrice
+		couponUsage.UpdateTime = requestTime
+		if err := c.repository.coupon.UpdateUsage(ctx, i, couponUsage); err != nil {
+			return fmt.Errorf("app.coupon.UseCoupon: error while update coupon usage: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return discountPrice, nil
+}
+
+// ReleaseCoupon releases the reserved coupon of the taxi call request, so that user can use it again
+func (c couponApp) ReleaseCoupon(ctx context.Context, taxiCallRequestId string) error {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	return c.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		couponUsage, err := c.repository.coupon.GetUsage(ctx, i, taxiCallRequestId)
+		if errors.Is(err, value.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("app.coupon.ReleaseCoupon: error while get coupon usage: %w", err)
+		}
+		if couponUsage.State != enum.CouponUsageState_RESERVED {
+			return nil
+		}
+
+		if err := c.repository.coupon.DecreaseUsageCount(ctx, i, couponUsage.CouponId); err != nil {
+			return fmt.Errorf("app.coupon.ReleaseCoupon: error while decrease usage count: %w", err)
+		}
+
+		couponUsage.State = enum.CouponUsageState_RELEASED
+		couponUsage.UpdateTime = requestTime
+		if err := c.repository.coupon.UpdateUsage(ctx, i, couponUsage); err != nil {
+			return fmt.Errorf("app.coupon.ReleaseCoupon: error while update coupon usage: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (c couponApp) getAvailableCoupon(ctx context.Context, db bun.IDB, couponCode string, taxiCallRequest entity.TaxiCallRequest) (entity.Coupon, error) {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	coupon, err := c.repository.coupon.GetByCode(ctx, db, couponCode)
+	if err != nil {
+		return entity.Coupon{}, fmt.Errorf("error while get coupon: %w", err)
+	}
+
+	if !coupon.Available(requestTime) {
+		return entity.Coupon{}, fmt.Errorf("expired or exhausted coupon: %w", value.ErrCouponUnavailable)
+	}
+
+	if !coupon.EligibleUser(taxiCallRequest.UserId) {
+		return entity.Coupon{}, fmt.Errorf("not eligible user: %w", value.ErrCouponUnavailable)
+	}
+
+	if !coupon.AvailableRegion(taxiCallRequest.Departure.Address, taxiCallRequest.Arrival.Address) {
+		return entity.Coupon{}, fmt.Errorf("not available region: %w", value.ErrCouponUnavailable)
+	}
+
+	if coupon.PerUserLimit > 0 {
+		usageCount, err := c.repository.coupon.CountUserUsage(ctx, db, coupon.Id, taxiCallRequest.UserId)
+		if err != nil {
+			return entity.Coupon{}, fmt.Errorf("error while count user usage: %w", err)
+		}
+		if usageCount >= coupon.PerUserLimit {
+			return entity.Coupon{}, fmt.Errorf("user usage limit exceeded: %w", value.ErrCouponUnavailable)
+		}
+	}
+
+	return coupon, nil
+}
@@ -0,0 +1,44 @@
+package coupon
+
+import (
+	"errors"
+
+	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/repository"
+)
+
+type couponAppOption func(*couponApp)
+
+func WithTransactor(transactor app.Transactor) couponAppOption {
+	return func(ca *couponApp) {
+		ca.Transactor = transactor
+	}
+}
+
+func WithCouponRepository(repo repository.CouponRepository) couponAppOption {
+	return func(ca *couponApp) {
+		ca.repository.coupon = repo
+	}
+}
+
+func (c couponApp) validateApp() error {
+	if c.Transactor == nil {
+		return errors.New("coupon app need transactor")
+	}
+
+	if c.repository.coupon == nil {
+		return errors.New("coupon app need coupon repository")
+	}
+
+	return nil
+}
+
+func NewCouponApp(opts ...couponAppOption) (couponApp, error) {
+	app := couponApp{}
+
+	for _, opt := range opts {
+		opt(&app)
+	}
+
+	return app, app.validateApp()
+}
@@ -0,0 +1,112 @@
+package deadletter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/request"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/taco-labs/taco/go/repository"
+	"github.com/taco-labs/taco/go/service"
+	"github.com/taco-labs/taco/go/utils"
+	"github.com/uptrace/bun"
+)
+
+type deadLetterApp struct {
+	app.Transactor
+	repository struct {
+		deadLetter repository.DeadLetterRepository
+	}
+	service struct {
+		eventPub map[enum.EventTopic]service.EventPublishService
+	}
+}
+
+// CreateDeadLetter persists the event which is failed to be handled until retry count is exhausted
+func (d deadLetterApp) CreateDeadLetter(ctx context.Context, topic enum.EventTopic, event entity.Event, cause error) error {
+	deadLetter := entity.NewDeadLetter(topic, event, cause, utils.GetRequestTimeOrNow(ctx))
+
+	return d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		if err := d.repository.deadLetter.Create(ctx, i, deadLetter); err != nil {
+			return fmt.Errorf("app.deadLetter.CreateDeadLetter: error while create dead letter: %w", err)
+		}
+		return nil
+	})
+}
+
+func (d deadLetterApp) ListDeadLetters(ctx context.Context, req request.ListDeadLetterRequest) ([]entity.DeadLetter, string, error) {
+	var deadLetters []entity.DeadLetter
+	var pageToken string
+	var err error
+
+	err = d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		deadLetters, pageToken, err = d.repository.deadLetter.List(ctx, i, enum.EventTopicFromString(req.Topic), req.PageToken, req.Count)
+		if err != nil {
+			return fmt.Errorf("app.deadLetter.ListDeadLetters: error while list dead letters: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return []entity.DeadLetter{}, "", err
+	}
+
+	return deadLetters, pageToken, nil
+}
+
+func (d deadLetterApp) GetDeadLetter(ctx context.Context, deadLetterId string) (entity.DeadLetter, error) {
+	var deadLetter entity.DeadLetter
+	var err error
+
+	err = d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		deadLetter, err = d.repository.deadLetter.Get(ctx, i, deadLetterId)
+		if err != nil {
+			return fmt.Errorf("app.deadLetter.GetDeadLetter: error while get dead letter: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return entity.DeadLetter{}, err
+	}
+
+	return deadLetter, nil
+}
+
+// ReplayDeadLetter publishes the event again with reset retry count and removes the dead letter
+func (d deadLetterApp) ReplayDeadLetter(ctx context.Context, deadLetterId string) error {
+	return d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		deadLetter, err := d.repository.deadLetter.Get(ctx, i, deadLetterId)
+		if err != nil {
+			return fmt.Errorf("app.deadLetter.ReplayDeadLetter: error while get dead letter: %w", err)
+		}
+
+		eventPub, ok := d.service.eventPub[deadLetter.Topic]
+		if !ok {
+			return fmt.Errorf("app.deadLetter.ReplayDeadLetter: unknown topic %s: %w", deadLetter.Topic, value.ErrInvalidOperation)
+		}
+
+		if err := d.repository.deadLetter.Delete(ctx, i, deadLetter); err != nil {
+			return fmt.Errorf("app.deadLetter.ReplayDeadLetter: error while delete dead letter: %w", err)
+		}
+
+		// Dead letter is restored by rollback if publish fails
+		if err := eventPub.SendMessage(ctx, deadLetter.ToEvent()); err != nil {
+			return fmt.Errorf("app.deadLetter.ReplayDeadLetter: error while publish event: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (d deadLetterApp) DiscardDeadLetter(ctx context.Context, deadLetterId string) error {
+	return d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		if err := d.repository.deadLetter.Delete(ctx, i, entity.DeadLetter{Id: deadLetterId}); err != nil {
+			return fmt.Errorf("app.deadLetter.DiscardDeadLetter: error while delete dead letter: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,62 @@
+package deadletter
+
+import (
+	"errors"
+
+	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/taco-labs/taco/go/repository"
+	"github.com/taco-labs/taco/go/service"
+)
+
+type deadLetterAppOption func(*deadLetterApp)
+
+func WithTransactor(transactor app.Transactor) deadLetterAppOption {
+	return func(dla *deadLetterApp) {
+		dla.Transactor = transactor
+	}
+}
+
+func WithDeadLetterRepository(repo repository.DeadLetterRepository) deadLetterAppOption {
+	return func(dla *deadLetterApp) {
+		dla.repository.deadLetter = repo
+	}
+}
+
+// WithEventPublisherService registers publisher of the topic which replayed event is sent to
+func WithEventPublisherService(topic enum.EventTopic, svc service.EventPublishService) deadLetterAppOption {
+	return func(dla *deadLetterApp) {
+		dla.service.eventPub[topic] = svc
+	}
+}
+
+func (d deadLetterApp) validateApp() error {
+	if d.Transactor == nil {
+		return errors.New("dead letter app need transactor")
+	}
+
+	if d.repository.deadLetter == nil {
+		return errors.New("dead letter app need dead letter repository")
+	}
+
+	if _, ok := d.service.eventPub[enum.EventTopic_TAXICALL]; !ok {
+		return errors.New("dead letter app need taxi call event publisher service")
+	}
+
+	if _, ok := d.service.eventPub[enum.EventTopic_NOTIFICATION]; !ok {
+		return errors.New("dead letter app need notification event publisher service")
+	}
+
+	return nil
+}
+
+func NewDeadLetterApp(opts ...deadLetterAppOption) (deadLetterApp, error) {
+	app := deadLetterApp{}
+	app.service.eventPub = make(map[enum.EventTopic]service.EventPublishService)
+
+	for _, opt := range opts {
+		opt(&app)
+	}
+
+	return app, app.validateApp()
+}
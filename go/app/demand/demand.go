@@ -0,0 +1,76 @@
+package demand
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/domain/request"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/repository"
+	"github.com/taco-labs/taco/go/utils"
+	"github.com/uptrace/bun"
+)
+
+const (
+	defaultWindow = 30 * time.Minute
+	maxWindow     = 3 * time.Hour
+)
+
+type demandApp struct {
+	app.Transactor
+	repository struct {
+		demand repository.DemandRepository
+	}
+	conf struct {
+		cellSize     float64 // Grid cell size in degree
+		searchRange  float64 // Half side of bounding box around the driver in degree
+		minUserCount int     // Minimum distinct riders of a cell to be exposed
+	}
+}
+
+// GetDemandHeatmap returns aggregated demand of cells around the requested location within the window
+func (d demandApp) GetDemandHeatmap(ctx context.Context, req request.DemandHeatmapRequest) (value.DemandHeatmap, error) {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	window := time.Duration(req.WindowMinutes) * time.Minute
+	if window <= 0 {
+		window = defaultWindow
+	}
+	if window > maxWindow {
+		return value.DemandHeatmap{}, fmt.Errorf("app.demand.GetDemandHeatmap: window exceeds %v: %w", maxWindow, value.ErrInvalidOperation)
+	}
+
+	from := requestTime.Add(-window)
+	southWest := value.Point{
+		Latitude:  req.Latitude - d.conf.searchRange,
+		Longitude: req.Longitude - d.conf.searchRange,
+	}
+	northEast := value.Point{
+		Latitude:  req.Latitude + d.conf.searchRange,
+		Longitude: req.Longitude + d.conf.searchRange,
+	}
+
+	var cells []value.DemandCell
+	var err error
+
+	err = d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		cells, err = d.repository.demand.ListCellDemands(ctx, i, from, southWest, northEast, d.conf.cellSize, d.conf.minUserCount)
+		if err != nil {
+			return fmt.Errorf("app.demand.GetDemandHeatmap: error while list cell demands: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return value.DemandHeatmap{}, err
+	}
+
+	return value.DemandHeatmap{
+		From:     from,
+		To:       requestTime,
+		CellSize: d.conf.cellSize,
+		Cells:    cells,
+	}, nil
+}
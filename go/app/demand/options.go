@@ -0,0 +1,74 @@
+package demand
+
+import (
+	"errors"
+
+	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/repository"
+)
+
+type demandAppOption func(*demandApp)
+
+func WithTransactor(transactor app.Transactor) demandAppOption {
+	return func(da *demandApp) {
+		da.Transactor = transactor
+	}
+}
+
+func WithDemandRepository(repo repository.DemandRepository) demandAppOption {
+	return func(da *demandApp) {
+		da.repository.demand = repo
+	}
+}
+
+func WithCellSize(cellSize float64) demandAppOption {
+	return func(da *demandApp) {
+		da.conf.cellSize = cellSize
+	}
+}
+
+func WithSearchRange(searchRange float64) demandAppOption {
+	return func(da *demandApp) {
+		da.conf.searchRange = searchRange
+	}
+}
+
+func WithMinUserCount(minUserCount int) demandAppOption {
+	return func(da *demandApp) {
+		da.conf.minUserCount = minUserCount
+	}
+}
+
+func (d demandApp) validateApp() error {
+	if d.Transactor == nil {
+		return errors.New("demand app need transactor")
+	}
+
+	if d.repository.demand == nil {
+		return errors.New("demand app need demand repository")
+	}
+
+	if d.conf.cellSize <= 0 {
+		return errors.New("demand app need positive cell size")
+	}
+
+	if d.conf.searchRange < d.conf.cellSize {
+		return errors.New("demand app need search range larger than cell size")
+	}
+
+	if d.conf.minUserCount < 2 {
+		return errors.New("demand app need at least 2 min user count for anonymization")
+	}
+
+	return nil
+}
+
+func NewDemandApp(opts ...demandAppOption) (demandApp, error) {
+	app := demandApp{}
+
+	for _, opt := range opts {
+		opt(&app)
+	}
+
+	return app, app.validateApp()
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"os"
 	"time"
 
 	// "time"
@@ -31,6 +30,8 @@ type pushServiceInterface interface {
 	CreatePushToken(context.Context, request.CreatePushTokenRequest) (entity.PushToken, error)
 	UpdatePushToken(context.Context, request.UpdatePushTokenRequest) error
 	DeletePushToken(context.Context, string) error
+	GetNotificationPreference(context.Context, string) (entity.NotificationPreference, error)
+	UpdateNotificationPreference(context.Context, request.UpdateNotificationPreferenceRequest) (entity.NotificationPreference, error)
 }
 
 type driverTaxiCallInterface interface {
@@ -43,7 +44,21 @@ type driverTaxiCallInterface interface {
 	AcceptTaxiCallRequest(ctx context.Context, driverId string, ticketId string) error
 	RejectTaxiCallRequest(ctx context.Context, driverId string, ticketId string) error
 	DriverToArrival(ctx context.Context, driverId string, callRequestId string) error
+	CancelDriverTaxiCallRequest(ctx context.Context, driverId string, callRequestId string) error
 	DoneTaxiCallRequest(ctx context.Context, driverId string, req request.DoneTaxiCallRequest) error
+	ListDriverTaxiCallHistory(ctx context.Context, driverId string, callRequestId string) ([]entity.TaxiCallHistory, error)
+}
+
+type dutyServiceInterface interface {
+	StartDutySession(context.Context, string) error
+	EndDutySession(context.Context, string, enum.DriverDutyEndReason) error
+	TouchDutySession(context.Context, string) error
+	ListDutySessions(context.Context, request.DriverDutyStatsRequest) ([]entity.DriverDutySession, error)
+	GetDutyStats(context.Context, request.DriverDutyStatsRequest) (entity.DriverDutyStats, error)
+}
+
+type demandServiceInterface interface {
+	GetDemandHeatmap(context.Context, request.DemandHeatmapRequest) (value.DemandHeatmap, error)
 }
 
 type driverApp struct {
@@ -53,6 +68,8 @@ type driverApp struct {
 		settlementAccount repository.DriverSettlementAccountRepository
 		smsVerification   repository.SmsVerificationRepository
 		event             repository.EventRepository
+		driverDocument    repository.DriverDocumentRepository
+		vehicle           repository.VehicleRepository
 	}
 
 	service struct {
@@ -61,6 +78,8 @@ type driverApp struct {
 		fileUpload service.FileUploadService
 		push       pushServiceInterface
 		taxiCall   driverTaxiCallInterface
+		duty       dutyServiceInterface
+		demand     demandServiceInterface
 	}
 
 	actor struct {
@@ -156,13 +175,7 @@ func (d driverApp) Signup(ctx context.Context, req request.DriverSignupRequest)
 	var newDriver entity.Driver
 	var driverSession entity.DriverSession
 
-	// TODO (taekyeom) Handle delete when signup failed
-	imageUrl, err := d.service.fileUpload.Upload(ctx, os.File{})
-	if err != nil {
-		return entity.Driver{}, "", fmt.Errorf("app.Driver.Signup: error while upload driver url: %w", err)
-	}
-
-	err = d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+	err := d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
 		driver, err := d.repository.driver.FindByUserUniqueKey(ctx, i, req.Phone)
 		if !errors.Is(err, value.ErrDriverNotFound) {
 			return fmt.Errorf("app.Driver.Signup: error while find driver by unique key:%w", err)
@@ -192,9 +205,10 @@ func (d driverApp) Signup(ctx context.Context, req request.DriverSignupRequest)
 			AppOs:                 enum.OsTypeFromString(req.AppOs),
 			AppVersion:            req.AppVersion,
 			AppFcmToken:           req.AppFcmToken,
+			Language:              enum.LanguageFromString(req.Language),
 			UserUniqueKey:         req.Phone,
 			DriverLicenseId:       req.DriverLicenseId,
-			DriverLicenseImageUrl: imageUrl,
+			DriverLicenseImageUrl: "",
 			OnDuty:                false,
 			Active:                false,
 			CreateTime:            requestTime,
@@ -272,6 +286,7 @@ func (d driverApp) UpdateDriver(ctx context.Context, req request.DriverUpdateReq
 		driver.AppOs = enum.OsTypeFromString(req.AppOs)
 		driver.AppVersion = req.AppVersion
 		driver.AppFcmToken = req.AppFcmToken
+		driver.Language = enum.LanguageFromString(req.Language)
 		driver.UpdateTime = requestTime
 
 		if err := d.repository.driver.Update(ctx, i, driver); err != nil {
@@ -317,13 +332,60 @@ func (d driverApp) UpdateOnDuty(ctx context.Context, req request.DriverOnDutyUpd
 		}
 
 		if req.OnDuty {
-			return d.service.taxiCall.ActivateDriverContext(ctx, req.DriverId)
+			if err := d.service.taxiCall.ActivateDriverContext(ctx, req.DriverId); err != nil {
+				return err
+			}
+			return d.service.duty.StartDutySession(ctx, req.DriverId)
 		} else {
-			return d.service.taxiCall.DeactivateDriverContext(ctx, req.DriverId)
+			if err := d.service.taxiCall.DeactivateDriverContext(ctx, req.DriverId); err != nil {
+				return err
+			}
+			return d.service.duty.EndDutySession(ctx, req.DriverId, enum.DriverDutyEndReason_MANUAL)
+		}
+	})
+}
+
+// ForceOffDuty turns off duty of the driver from backoffice
+func (d driverApp) ForceOffDuty(ctx context.Context, driverId string) error {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	return d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		driver, err := d.repository.driver.FindById(ctx, i, driverId)
+		if err != nil {
+			return fmt.Errorf("app.Driver.ForceOffDuty: error while find driver: %w", err)
+		}
+
+		if !driver.OnDuty {
+			return nil
+		}
+
+		driver.OnDuty = false
+		driver.UpdateTime = requestTime
+
+		if err := d.repository.driver.Update(ctx, i, driver); err != nil {
+			return fmt.Errorf("app.Driver.ForceOffDuty: error while update driver: %w", err)
 		}
+
+		if err := d.service.taxiCall.DeactivateDriverContext(ctx, driverId); err != nil {
+			return fmt.Errorf("app.Driver.ForceOffDuty: error while deactivate driver context: %w", err)
+		}
+
+		return d.service.duty.EndDutySession(ctx, driverId, enum.DriverDutyEndReason_FORCED)
 	})
 }
 
+func (d driverApp) ListDutySessions(ctx context.Context, req request.DriverDutyStatsRequest) ([]entity.DriverDutySession, error) {
+	return d.service.duty.ListDutySessions(ctx, req)
+}
+
+func (d driverApp) GetDutyStats(ctx context.Context, req request.DriverDutyStatsRequest) (entity.DriverDutyStats, error) {
+	return d.service.duty.GetDutyStats(ctx, req)
+}
+
+func (d driverApp) GetDemandHeatmap(ctx context.Context, req request.DemandHeatmapRequest) (value.DemandHeatmap, error) {
+	return d.service.demand.GetDemandHeatmap(ctx, req)
+}
+
 func (d driverApp) UpdateDriverLocation(ctx context.Context, req request.DriverLocationUpdateRequest) error {
 	return d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
 		driver, err := d.repository.driver.FindById(ctx, i, req.DriverId)
@@ -335,6 +397,10 @@ func (d driverApp) UpdateDriverLocation(ctx context.Context, req request.DriverL
 			return fmt.Errorf("app.Driver.UpdateDriverLocation: driver is not on duty: %w", value.ErrInvalidOperation)
 		}
 
+		if err := d.service.duty.TouchDutySession(ctx, req.DriverId); err != nil {
+			return fmt.Errorf("app.Driver.UpdateDriverLocation: error while touch duty session: %w", err)
+		}
+
 		return d.service.taxiCall.UpdateDriverLocation(ctx, req)
 	})
 }
@@ -360,12 +426,32 @@ func (d driverApp) DeleteDriver(ctx context.Context, driverId string) error {
 }
 
 func (d driverApp) ActivateDriver(ctx context.Context, driverId string) error {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
 	return d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
 		driver, err := d.repository.driver.FindById(ctx, i, driverId)
 		if err != nil {
 			return fmt.Errorf("app.Driver.ActivateDriver: error while find driver by id:%w", err)
 		}
+
+		documents, err := d.repository.driverDocument.ListByDriverId(ctx, i, driverId)
+		if err != nil {
+			return fmt.Errorf("app.Driver.ActivateDriver: error while list driver documents:%w", err)
+		}
+		if !entity.DriverDocuments(documents).Verified(requestTime) {
+			return fmt.Errorf("app.Driver.ActivateDriver: required documents are not approved:%w", value.ErrDriverDocumentNotVerified)
+		}
+
+		vehicle, err := d.repository.vehicle.GetByDriverId(ctx, i, driverId)
+		if err != nil && !errors.Is(err, value.ErrNotFound) {
+			return fmt.Errorf("app.Driver.ActivateDriver: error while get vehicle:%w", err)
+		}
+		if !vehicle.Verified {
+			return fmt.Errorf("app.Driver.ActivateDriver: vehicle is not registered or verified:%w", value.ErrVehicleNotVerified)
+		}
+
 		driver.Active = true
+		driver.UpdateTime = requestTime
 
 		if err := d.repository.driver.Update(ctx, i, driver); err != nil {
 			return fmt.Errorf("app.Driver.ActivateDriver: error while activate driver:%w", err)
@@ -0,0 +1,244 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/request"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/taco-labs/taco/go/utils"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+const defaultDriverDocumentReviewQueueSize = 20
+
+func (d driverApp) UploadDriverDocument(ctx context.Context, req request.DriverDocumentUploadRequest) (entity.DriverDocument, error) {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	documentType := enum.DriverDocumentTypeFromString(req.DocumentType)
+	if documentType == enum.DriverDocumentType_UNKNOWN {
+		return entity.DriverDocument{}, fmt.Errorf("app.Driver.UploadDriverDocument: unknown document type %s: %w", req.DocumentType, value.ErrInvalidOperation)
+	}
+	if !strings.HasPrefix(req.ContentType, "image/") {
+		return entity.DriverDocument{}, fmt.Errorf("app.Driver.UploadDriverDocument: document must be an image: %w", value.ErrInvalidOperation)
+	}
+
+	document := entity.DriverDocument{
+		DriverId:     req.DriverId,
+		DocumentType: documentType,
+		FileKey:      fmt.Sprintf("driver/%s/document/%s/%s", req.DriverId, documentType, utils.MustNewUUID()),
+		State:        enum.DriverDocumentState_PENDING,
+		CreateTime:   requestTime,
+		UpdateTime:   requestTime,
+	}
+
+	if err := d.service.fileUpload.Upload(ctx, document.FileKey, req.ContentType, req.File); err != nil {
+		return entity.DriverDocument{}, fmt.Errorf("app.Driver.UploadDriverDocument: error while upload document: %w", err)
+	}
+
+	var previousFileKey string
+	err := d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		driver, err := d.repository.driver.FindById(ctx, i, req.DriverId)
+		if err != nil {
+			return fmt.Errorf("app.Driver.UploadDriverDocument: error while find driver by id: %w", err)
+		}
+
+		previous, err := d.repository.driverDocument.Get(ctx, i, req.DriverId, documentType)
+		if err != nil && !errors.Is(err, value.ErrNotFound) {
+			return fmt.Errorf("app.Driver.UploadDriverDocument: error while get previous document: %w", err)
+		}
+		// Approved document can not be replaced with pending one until expired, since driver stays active with it
+		if previous.Valid(requestTime) {
+			return fmt.Errorf("app.Driver.UploadDriverDocument: %w", value.ErrDriverDocumentAlreadyApproved)
+		}
+		previousFileKey = previous.FileKey
+
+		if err := d.repository.driverDocument.Upsert(ctx, i, document); err != nil {
+			return fmt.Errorf("app.Driver.UploadDriverDocument: error while upsert document: %w", err)
+		}
+
+		if documentType == enum.DriverDocumentType_DRIVER_LICENSE {
+			driver.DriverLicenseImageUrl = document.FileKey
+			driver.UpdateTime = requestTime
+			if err := d.repository.driver.Update(ctx, i, driver); err != nil {
+				return fmt.Errorf("app.Driver.UploadDriverDocument: error while update driver: %w", err)
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		if deleteErr := d.service.fileUpload.Delete(ctx, document.FileKey); deleteErr != nil {
+			return entity.DriverDocument{}, fmt.Errorf("%w (error while delete uploaded document: %v)", err, deleteErr)
+		}
+		return entity.DriverDocument{}, err
+	}
+
+	// Replaced file is deleted after commit, failure only leaves orphan file
+	if previousFileKey != "" {
+		if err := d.service.fileUpload.Delete(ctx, previousFileKey); err != nil {
+			utils.GetLogger(ctx).Warn("error while delete replaced document",
+				zap.String("driver_id", req.DriverId), zap.String("file_key", previousFileKey), zap.Error(err))
+		}
+	}
+
+	return document, nil
+}
+
+func (d driverApp) ListDriverDocuments(ctx context.Context, driverId string) ([]entity.DriverDocument, error) {
+	var documents []entity.DriverDocument
+	var err error
+
+	err = d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		documents, err = d.repository.driverDocument.ListByDriverId(ctx, i, driverId)
+		if err != nil {
+			return fmt.Errorf("app.Driver.ListDriverDocuments: error while list documents: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return []entity.DriverDocument{}, err
+	}
+
+	return documents, nil
+}
+
+// ListDriverDocumentReviewQueue lists pending documents, oldest uploaded first
+func (d driverApp) ListDriverDocumentReviewQueue(ctx context.Context, req request.ListDriverDocumentReviewQueueRequest) ([]entity.DriverDocument, error) {
+	count := req.Count
+	if count <= 0 {
+		count = defaultDriverDocumentReviewQueueSize
+	}
+
+	var documents []entity.DriverDocument
+	var err error
+
+	err = d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		documents, err = d.repository.driverDocument.ListByState(ctx, i, enum.DriverDocumentState_PENDING, count)
+		if err != nil {
+			return fmt.Errorf("app.Driver.ListDriverDocumentReviewQueue: error while list documents: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return []entity.DriverDocument{}, err
+	}
+
+	return documents, nil
+}
+
+func (d driverApp) GetDriverDocumentDownloadUrl(ctx context.Context, driverId string, documentTypeStr string) (string, error) {
+	var url string
+
+	err := d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		document, err := d.repository.driverDocument.Get(ctx, i, driverId, enum.DriverDocumentTypeFromString(documentTypeStr))
+		if err != nil {
+			return fmt.Errorf("app.Driver.GetDriverDocumentDownloadUrl: error while get document: %w", err)
+		}
+
+		url, err = d.service.fileUpload.GetDownloadUrl(ctx, document.FileKey)
+		if err != nil {
+			return fmt.Errorf("app.Driver.GetDriverDocumentDownloadUrl: error while get download url: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return url, nil
+}
+
+func (d driverApp) ApproveDriverDocument(ctx context.Context, req request.ApproveDriverDocumentRequest) (entity.DriverDocument, error) {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	if !req.ExpireTime.After(requestTime) {
+		return entity.DriverDocument{}, fmt.Errorf("app.Driver.ApproveDriverDocument: expire time must be in the future: %w", value.ErrInvalidOperation)
+	}
+
+	var document entity.DriverDocument
+	var err error
+
+	err = d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		document, err = d.getPendingDocument(ctx, i, req.DriverId, req.DocumentType)
+		if err != nil {
+			return fmt.Errorf("app.Driver.ApproveDriverDocument: %w", err)
+		}
+
+		document.State = enum.DriverDocumentState_APPROVED
+		document.RejectReason = ""
+		document.ExpireTime = req.ExpireTime
+		document.ReviewTime = requestTime
+		document.UpdateTime = requestTime
+
+		if err := d.repository.driverDocument.Update(ctx, i, document); err != nil {
+			return fmt.Errorf("app.Driver.ApproveDriverDocument: error while update document: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return entity.DriverDocument{}, err
+	}
+
+	return document, nil
+}
+
+func (d driverApp) RejectDriverDocument(ctx context.Context, req request.RejectDriverDocumentRequest) (entity.DriverDocument, error) {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	if req.RejectReason == "" {
+		return entity.DriverDocument{}, fmt.Errorf("app.Driver.RejectDriverDocument: reject reason is required: %w", value.ErrInvalidOperation)
+	}
+
+	var document entity.DriverDocument
+	var err error
+
+	err = d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		document, err = d.getPendingDocument(ctx, i, req.DriverId, req.DocumentType)
+		if err != nil {
+			return fmt.Errorf("app.Driver.RejectDriverDocument: %w", err)
+		}
+
+		document.State = enum.DriverDocumentState_REJECTED
+		document.RejectReason = req.RejectReason
+		document.ReviewTime = requestTime
+		document.UpdateTime = requestTime
+
+		if err := d.repository.driverDocument.Update(ctx, i, document); err != nil {
+			return fmt.Errorf("app.Driver.RejectDriverDocument: error while update document: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return entity.DriverDocument{}, err
+	}
+
+	return document, nil
+}
+
+func (d driverApp) getPendingDocument(ctx context.Context, db bun.IDB, driverId string, documentTypeStr string) (entity.DriverDocument, error) {
+	document, err := d.repository.driverDocument.Get(ctx, db, driverId, enum.DriverDocumentTypeFromString(documentTypeStr))
+	if err != nil {
+		return entity.DriverDocument{}, fmt.Errorf("error while get document: %w", err)
+	}
+
+	if document.State != enum.DriverDocumentState_PENDING {
+		return entity.DriverDocument{}, fmt.Errorf("document is already reviewed: %w", value.ErrInvalidOperation)
+	}
+
+	return document, nil
+}
@@ -0,0 +1,27 @@
+package driver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/request"
+)
+
+func (d driverApp) GetNotificationPreference(ctx context.Context, driverId string) (entity.NotificationPreference, error) {
+	preference, err := d.service.push.GetNotificationPreference(ctx, driverId)
+	if err != nil {
+		return entity.NotificationPreference{}, fmt.Errorf("app.driver.GetNotificationPreference: error while get notification preference: %w", err)
+	}
+
+	return preference, nil
+}
+
+func (d driverApp) UpdateNotificationPreference(ctx context.Context, req request.UpdateNotificationPreferenceRequest) (entity.NotificationPreference, error) {
+	preference, err := d.service.push.UpdateNotificationPreference(ctx, req)
+	if err != nil {
+		return entity.NotificationPreference{}, fmt.Errorf("app.driver.UpdateNotificationPreference: error while update notification preference: %w", err)
+	}
+
+	return preference, nil
+}
@@ -26,6 +26,11 @@ func (d driverApp) DriverToArrival(ctx context.Context, callRequestId string) er
 	return d.service.taxiCall.DriverToArrival(ctx, driverId, callRequestId)
 }
 
+func (d driverApp) CancelTaxiCallRequest(ctx context.Context, callRequestId string) error {
+	driverId := utils.GetDriverId(ctx)
+	return d.service.taxiCall.CancelDriverTaxiCallRequest(ctx, driverId, callRequestId)
+}
+
 func (d driverApp) AcceptTaxiCallRequest(ctx context.Context, ticketId string) error {
 	driverId := utils.GetDriverId(ctx)
 
@@ -43,3 +48,8 @@ func (d driverApp) DoneTaxiCallRequest(ctx context.Context, req request.DoneTaxi
 
 	return d.service.taxiCall.DoneTaxiCallRequest(ctx, driverId, req)
 }
+
+func (d driverApp) ListTaxiCallHistory(ctx context.Context, callRequestId string) ([]entity.TaxiCallHistory, error) {
+	driverId := utils.GetDriverId(ctx)
+	return d.service.taxiCall.ListDriverTaxiCallHistory(ctx, driverId, callRequestId)
+}
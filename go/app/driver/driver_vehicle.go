@@ -0,0 +1,110 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/request"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/taco-labs/taco/go/utils"
+	"github.com/uptrace/bun"
+)
+
+func (d driverApp) GetVehicle(ctx context.Context, driverId string) (entity.Vehicle, error) {
+	var vehicle entity.Vehicle
+	var err error
+
+	err = d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		vehicle, err = d.repository.vehicle.GetByDriverId(ctx, i, driverId)
+		if err != nil {
+			return fmt.Errorf("app.Driver.GetVehicle: error while get vehicle: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return entity.Vehicle{}, err
+	}
+
+	return vehicle, nil
+}
+
+// UpsertVehicle registers or updates vehicle of the driver. Updated vehicle needs to be verified again.
+func (d driverApp) UpsertVehicle(ctx context.Context, req request.UpsertVehicleRequest) (entity.Vehicle, error) {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	if err := req.Validate(); err != nil {
+		return entity.Vehicle{}, fmt.Errorf("app.Driver.UpsertVehicle: invalid request: %w", err)
+	}
+
+	vehicle := entity.Vehicle{
+		DriverId:     req.DriverId,
+		PlateNumber:  req.PlateNumber,
+		Model:        req.Model,
+		Color:        req.Color,
+		Capacity:     req.Capacity,
+		VehicleClass: enum.VehicleClassFromString(req.VehicleClass),
+		Verified:     false,
+		CreateTime:   requestTime,
+		UpdateTime:   requestTime,
+	}
+
+	err := d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		if _, err := d.repository.driver.FindById(ctx, i, req.DriverId); err != nil {
+			return fmt.Errorf("app.Driver.UpsertVehicle: error while find driver by id: %w", err)
+		}
+
+		registered, err := d.repository.vehicle.GetByPlateNumber(ctx, i, req.PlateNumber)
+		if err != nil && !errors.Is(err, value.ErrNotFound) {
+			return fmt.Errorf("app.Driver.UpsertVehicle: error while get vehicle by plate number: %w", err)
+		}
+		if err == nil && registered.DriverId != req.DriverId {
+			return fmt.Errorf("app.Driver.UpsertVehicle: plate number is registered by another driver: %w", value.ErrAlreadyExists)
+		}
+
+		if err := d.repository.vehicle.Upsert(ctx, i, vehicle); err != nil {
+			return fmt.Errorf("app.Driver.UpsertVehicle: error while upsert vehicle: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return entity.Vehicle{}, err
+	}
+
+	return vehicle, nil
+}
+
+func (d driverApp) VerifyVehicle(ctx context.Context, driverId string) (entity.Vehicle, error) {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	var vehicle entity.Vehicle
+	var err error
+
+	err = d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		vehicle, err = d.repository.vehicle.GetByDriverId(ctx, i, driverId)
+		if err != nil {
+			return fmt.Errorf("app.Driver.VerifyVehicle: error while get vehicle: %w", err)
+		}
+
+		vehicle.Verified = true
+		vehicle.VerifyTime = requestTime
+		vehicle.UpdateTime = requestTime
+
+		if err := d.repository.vehicle.Update(ctx, i, vehicle); err != nil {
+			return fmt.Errorf("app.Driver.VerifyVehicle: error while update vehicle: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return entity.Vehicle{}, err
+	}
+
+	return vehicle, nil
+}
@@ -40,6 +40,18 @@ func WithEventRepository(repo repository.EventRepository) driverAppOption {
 	}
 }
 
+func WithDriverDocumentRepository(repo repository.DriverDocumentRepository) driverAppOption {
+	return func(da *driverApp) {
+		da.repository.driverDocument = repo
+	}
+}
+
+func WithVehicleRepository(repo repository.VehicleRepository) driverAppOption {
+	return func(da *driverApp) {
+		da.repository.vehicle = repo
+	}
+}
+
 func WithSessionService(svc sessionServiceInterface) driverAppOption {
 	return func(da *driverApp) {
 		da.service.session = svc
@@ -70,6 +82,18 @@ func WithTaxiCallService(svc driverTaxiCallInterface) driverAppOption {
 	}
 }
 
+func WithDutyService(svc dutyServiceInterface) driverAppOption {
+	return func(da *driverApp) {
+		da.service.duty = svc
+	}
+}
+
+func WithDemandService(svc demandServiceInterface) driverAppOption {
+	return func(da *driverApp) {
+		da.service.demand = svc
+	}
+}
+
 func (d driverApp) validateApp() error {
 	if d.Transactor == nil {
 		return errors.New("driver app need transactor")
@@ -91,6 +115,14 @@ func (d driverApp) validateApp() error {
 		return errors.New("driver app need event repository")
 	}
 
+	if d.repository.driverDocument == nil {
+		return errors.New("driver app need driver document repository")
+	}
+
+	if d.repository.vehicle == nil {
+		return errors.New("driver app need vehicle repository")
+	}
+
 	if d.service.session == nil {
 		return errors.New("driver app need driver session service")
 	}
@@ -111,5 +143,13 @@ func (d driverApp) validateApp() error {
 		return errors.New("driver app need taxi call service")
 	}
 
+	if d.service.duty == nil {
+		return errors.New("driver app need duty service")
+	}
+
+	if d.service.demand == nil {
+		return errors.New("driver app need demand service")
+	}
+
 	return nil
 }
@@ -0,0 +1,168 @@
+package driverduty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/request"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/taco-labs/taco/go/repository"
+	"github.com/taco-labs/taco/go/utils"
+	"github.com/uptrace/bun"
+)
+
+const defaultStatsPeriod = 7 * 24 * time.Hour
+
+type taxiCallServiceInterface interface {
+	DeactivateDriverContext(ctx context.Context, driverId string) error
+}
+
+type driverDutyApp struct {
+	app.Transactor
+	repository struct {
+		dutySession repository.DriverDutySessionRepository
+		driver      repository.DriverRepository
+	}
+	service struct {
+		taxiCall taxiCallServiceInterface
+	}
+	conf struct {
+		timeout       time.Duration // Duty session without activity for timeout is ended
+		checkInterval time.Duration
+	}
+	stopCh chan struct{}
+	waitCh chan struct{}
+}
+
+// StartDutySession starts new duty session of the driver if there is no active one
+func (d driverDutyApp) StartDutySession(ctx context.Context, driverId string) error {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	return d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		_, err := d.repository.dutySession.GetActiveByDriverId(ctx, i, driverId)
+		if err != nil && !errors.Is(err, value.ErrNotFound) {
+			return fmt.Errorf("app.driverDuty.StartDutySession: error while get active duty session: %w", err)
+		}
+		if err == nil {
+			return nil
+		}
+
+		dutySession := entity.DriverDutySession{
+			Id:             utils.MustNewUUID(),
+			DriverId:       driverId,
+			StartTime:      requestTime,
+			LastActiveTime: requestTime,
+		}
+		if err := d.repository.dutySession.Create(ctx, i, dutySession); err != nil {
+			return fmt.Errorf("app.driverDuty.StartDutySession: error while create duty session: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// EndDutySession ends active duty session of the driver with the reason
+func (d driverDutyApp) EndDutySession(ctx context.Context, driverId string, reason enum.DriverDutyEndReason) error {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	return d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		dutySession, err := d.repository.dutySession.GetActiveByDriverId(ctx, i, driverId)
+		if errors.Is(err, value.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("app.driverDuty.EndDutySession: error while get active duty session: %w", err)
+		}
+
+		dutySession.EndTime = requestTime
+		dutySession.EndReason = reason
+		if err := d.repository.dutySession.Update(ctx, i, dutySession); err != nil {
+			return fmt.Errorf("app.driverDuty.EndDutySession: error while update duty session: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// TouchDutySession marks active duty session of the driver is still alive
+func (d driverDutyApp) TouchDutySession(ctx context.Context, driverId string) error {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	return d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		if err := d.repository.dutySession.UpdateLastActiveTime(ctx, i, driverId, requestTime); err != nil {
+			return fmt.Errorf("app.driverDuty.TouchDutySession: error while update last active time: %w", err)
+		}
+		return nil
+	})
+}
+
+func (d driverDutyApp) ListDutySessions(ctx context.Context, req request.DriverDutyStatsRequest) ([]entity.DriverDutySession, error) {
+	from, to := d.getPeriod(ctx, req)
+
+	var dutySessions []entity.DriverDutySession
+	var err error
+
+	err = d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		dutySessions, err = d.repository.dutySession.ListByDriverId(ctx, i, req.DriverId, from, to)
+		if err != nil {
+			return fmt.Errorf("app.driverDuty.ListDutySessions: error while list duty sessions: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return []entity.DriverDutySession{}, err
+	}
+
+	return dutySessions, nil
+}
+
+func (d driverDutyApp) GetDutyStats(ctx context.Context, req request.DriverDutyStatsRequest) (entity.DriverDutyStats, error) {
+	from, to := d.getPeriod(ctx, req)
+	if !to.After(from) {
+		return entity.DriverDutyStats{}, fmt.Errorf("app.driverDuty.GetDutyStats: invalid period: %w", value.ErrInvalidOperation)
+	}
+
+	var stats entity.DriverDutyStats
+
+	err := d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		dutySessions, err := d.repository.dutySession.ListByDriverId(ctx, i, req.DriverId, from, to)
+		if err != nil {
+			return fmt.Errorf("app.driverDuty.GetDutyStats: error while list duty sessions: %w", err)
+		}
+
+		rideCount, rideDuration, err := d.repository.dutySession.SummarizeRides(ctx, i, req.DriverId, from, to)
+		if err != nil {
+			return fmt.Errorf("app.driverDuty.GetDutyStats: error while summarize rides: %w", err)
+		}
+
+		stats = entity.NewDriverDutyStats(req.DriverId, from, to, dutySessions, rideCount, rideDuration)
+		return nil
+	})
+
+	if err != nil {
+		return entity.DriverDutyStats{}, err
+	}
+
+	return stats, nil
+}
+
+// getPeriod returns [from, to) of the request, last 7 days until now by default
+func (d driverDutyApp) getPeriod(ctx context.Context, req request.DriverDutyStatsRequest) (time.Time, time.Time) {
+	to := req.To
+	if to.IsZero() {
+		to = utils.GetRequestTimeOrNow(ctx)
+	}
+
+	from := req.From
+	if from.IsZero() {
+		from = to.Add(-defaultStatsPeriod)
+	}
+
+	return from, to
+}
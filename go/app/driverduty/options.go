@@ -0,0 +1,88 @@
+package driverduty
+
+import (
+	"errors"
+	"time"
+
+	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/repository"
+)
+
+type driverDutyAppOption func(*driverDutyApp)
+
+func WithTransactor(transactor app.Transactor) driverDutyAppOption {
+	return func(da *driverDutyApp) {
+		da.Transactor = transactor
+	}
+}
+
+func WithDriverDutySessionRepository(repo repository.DriverDutySessionRepository) driverDutyAppOption {
+	return func(da *driverDutyApp) {
+		da.repository.dutySession = repo
+	}
+}
+
+func WithDriverRepository(repo repository.DriverRepository) driverDutyAppOption {
+	return func(da *driverDutyApp) {
+		da.repository.driver = repo
+	}
+}
+
+func WithTaxiCallService(svc taxiCallServiceInterface) driverDutyAppOption {
+	return func(da *driverDutyApp) {
+		da.service.taxiCall = svc
+	}
+}
+
+func WithTimeout(timeout time.Duration) driverDutyAppOption {
+	return func(da *driverDutyApp) {
+		da.conf.timeout = timeout
+	}
+}
+
+func WithCheckInterval(checkInterval time.Duration) driverDutyAppOption {
+	return func(da *driverDutyApp) {
+		da.conf.checkInterval = checkInterval
+	}
+}
+
+func (d driverDutyApp) validateApp() error {
+	if d.Transactor == nil {
+		return errors.New("driver duty app need transactor")
+	}
+
+	if d.repository.dutySession == nil {
+		return errors.New("driver duty app need driver duty session repository")
+	}
+
+	if d.repository.driver == nil {
+		return errors.New("driver duty app need driver repository")
+	}
+
+	if d.service.taxiCall == nil {
+		return errors.New("driver duty app need taxi call service")
+	}
+
+	if d.conf.timeout < time.Minute {
+		return errors.New("driver duty app required at least 1 minute timeout")
+	}
+
+	if d.conf.checkInterval < time.Second {
+		return errors.New("driver duty app required at least 1 second check interval")
+	}
+
+	return nil
+}
+
+func NewDriverDutyApp(opts ...driverDutyAppOption) (driverDutyApp, error) {
+	app := driverDutyApp{
+		stopCh: make(chan struct{}),
+		waitCh: make(chan struct{}, 1),
+	}
+
+	for _, opt := range opts {
+		opt(&app)
+	}
+
+	return app, app.validateApp()
+}
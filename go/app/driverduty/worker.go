@@ -0,0 +1,114 @@
+package driverduty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/taco-labs/taco/go/utils"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+const staleSessionBatchSize = 50
+
+func (d driverDutyApp) Start(ctx context.Context) error {
+	go d.loop(ctx)
+	return nil
+}
+
+func (d driverDutyApp) Stop(ctx context.Context) error {
+	close(d.stopCh)
+	select {
+	case <-d.waitCh:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("app.driverDuty.Stop: error while wait session expiration: %w", ctx.Err())
+	}
+}
+
+func (d driverDutyApp) loop(ctx context.Context) {
+	stopCtx, cancel := app.WithStop(ctx, d.stopCh)
+	defer cancel()
+
+	ticker := time.NewTicker(d.conf.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCtx.Done():
+			utils.GetLogger(ctx).Info("shutting down driver duty session expirer")
+			d.waitCh <- struct{}{}
+			return
+		case <-ticker.C:
+			if err := d.expireStaleSessions(ctx); err != nil {
+				utils.GetLogger(ctx).Error("error while expire stale duty sessions", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (d driverDutyApp) expireStaleSessions(ctx context.Context) error {
+	var dutySessions []entity.DriverDutySession
+	var err error
+
+	err = d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		dutySessions, err = d.repository.dutySession.ListStale(ctx, i, time.Now().Add(-d.conf.timeout), staleSessionBatchSize)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("app.driverDuty.expireStaleSessions: error while list stale duty sessions: %w", err)
+	}
+
+	for _, dutySession := range dutySessions {
+		if err := d.expireSession(ctx, dutySession); err != nil {
+			utils.GetLogger(ctx).Error("error while expire duty session",
+				zap.String("duty_session_id", dutySession.Id), zap.String(utils.LogKey_PrincipalId, dutySession.DriverId), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// expireSession turns off duty of the driver. Session is ended at the last active time, so that inactive
+// duration is not counted as online duration.
+func (d driverDutyApp) expireSession(ctx context.Context, dutySession entity.DriverDutySession) error {
+	err := d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		driver, err := d.repository.driver.FindById(ctx, i, dutySession.DriverId)
+		if err != nil {
+			return fmt.Errorf("app.driverDuty.expireSession: error while find driver: %w", err)
+		}
+
+		if err := d.service.taxiCall.DeactivateDriverContext(ctx, dutySession.DriverId); err != nil {
+			return fmt.Errorf("app.driverDuty.expireSession: error while deactivate driver context: %w", err)
+		}
+
+		driver.OnDuty = false
+		driver.UpdateTime = time.Now()
+		if err := d.repository.driver.Update(ctx, i, driver); err != nil {
+			return fmt.Errorf("app.driverDuty.expireSession: error while update driver: %w", err)
+		}
+
+		dutySession.EndTime = dutySession.LastActiveTime
+		dutySession.EndReason = enum.DriverDutyEndReason_TIMEOUT
+		if err := d.repository.dutySession.Update(ctx, i, dutySession); err != nil {
+			return fmt.Errorf("app.driverDuty.expireSession: error while update duty session: %w", err)
+		}
+
+		return nil
+	})
+
+	// Driver on a ride may not update location. Keep the session until the ride is finished.
+	if errors.Is(err, value.ErrActiveTaxiCallRequestExists) {
+		return d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+			return d.repository.dutySession.UpdateLastActiveTime(ctx, i, dutySession.DriverId, time.Now())
+		})
+	}
+
+	return err
+}
@@ -0,0 +1,121 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/repository"
+	"github.com/taco-labs/taco/go/utils"
+	"github.com/uptrace/bun"
+)
+
+type idempotencyApp struct {
+	app.Transactor
+	repository struct {
+		idempotencyKey repository.IdempotencyKeyRepository
+	}
+	conf struct {
+		ttl             time.Duration
+		cleanupInterval time.Duration
+		lease           time.Duration
+	}
+	stopCh chan struct{}
+	waitCh chan struct{}
+}
+
+// Begin reserves the idempotency key for the request. If the key is already reserved by previous request,
+// returns the stored one with false, so that caller can replay its response.
+// Key of the previous request which is not completed within the lease is taken over by the request.
+func (i idempotencyApp) Begin(ctx context.Context, principalId string, key string, route string, requestHash string) (entity.IdempotencyKey, bool, error) {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	idempotencyKey := entity.IdempotencyKey{
+		PrincipalId:     principalId,
+		Key:             key,
+		Route:           route,
+		RequestHash:     requestHash,
+		CreateTime:      requestTime,
+		ExpireTime:      requestTime.Add(i.conf.ttl),
+		LeaseExpireTime: requestTime.Add(i.conf.lease),
+	}
+
+	var created bool
+
+	err := i.Run(ctx, func(ctx context.Context, db bun.IDB) error {
+		stored, err := i.repository.idempotencyKey.Get(ctx, db, principalId, key)
+		if err != nil && !errors.Is(err, value.ErrNotFound) {
+			return fmt.Errorf("app.idempotency.Begin: error while get idempotency key: %w", err)
+		}
+
+		if err == nil && stored.Expired(requestTime) {
+			if err := i.repository.idempotencyKey.Delete(ctx, db, principalId, key); err != nil {
+				return fmt.Errorf("app.idempotency.Begin: error while delete expired idempotency key: %w", err)
+			}
+		} else if err == nil {
+			if !stored.Match(idempotencyKey) {
+				return fmt.Errorf("app.idempotency.Begin: %w", value.ErrIdempotencyKeyMismatch)
+			}
+			if !stored.Abandoned(requestTime) {
+				idempotencyKey = stored
+				return nil
+			}
+
+			created, err = i.repository.idempotencyKey.TakeOver(ctx, db, stored, idempotencyKey.LeaseExpireTime)
+			if err != nil {
+				return fmt.Errorf("app.idempotency.Begin: error while take over abandoned idempotency key: %w", err)
+			}
+			if !created {
+				// Concurrent retry took it over first
+				return fmt.Errorf("app.idempotency.Begin: %w", value.ErrIdempotencyKeyInProgress)
+			}
+			stored.LeaseExpireTime = idempotencyKey.LeaseExpireTime
+			idempotencyKey = stored
+			return nil
+		}
+
+		created, err = i.repository.idempotencyKey.Create(ctx, db, idempotencyKey)
+		if err != nil {
+			return fmt.Errorf("app.idempotency.Begin: error while create idempotency key: %w", err)
+		}
+		if !created {
+			// Concurrent request with the same key reserved it first
+			return fmt.Errorf("app.idempotency.Begin: %w", value.ErrIdempotencyKeyInProgress)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return entity.IdempotencyKey{}, false, err
+	}
+
+	return idempotencyKey, created, nil
+}
+
+// Complete stores the response of the request
+func (i idempotencyApp) Complete(ctx context.Context, idempotencyKey entity.IdempotencyKey, status int, body []byte) error {
+	idempotencyKey.ResponseStatus = status
+	idempotencyKey.ResponseBody = body
+
+	return i.Run(ctx, func(ctx context.Context, db bun.IDB) error {
+		if err := i.repository.idempotencyKey.Update(ctx, db, idempotencyKey); err != nil {
+			return fmt.Errorf("app.idempotency.Complete: error while update idempotency key: %w", err)
+		}
+		return nil
+	})
+}
+
+// Release deletes the reserved key so that client can retry the failed request with the same key
+func (i idempotencyApp) Release(ctx context.Context, idempotencyKey entity.IdempotencyKey) error {
+	return i.Run(ctx, func(ctx context.Context, db bun.IDB) error {
+		if err := i.repository.idempotencyKey.Delete(ctx, db, idempotencyKey.PrincipalId, idempotencyKey.Key); err != nil {
+			return fmt.Errorf("app.idempotency.Release: error while delete idempotency key: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,166 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/utils"
+	"github.com/uptrace/bun"
+)
+
+type fakeTransactor struct{}
+
+func (f fakeTransactor) Run(ctx context.Context, fn func(context.Context, bun.IDB) error) error {
+	return fn(ctx, nil)
+}
+
+func (f fakeTransactor) RunWithNonRollbackError(ctx context.Context, _ error, fn func(context.Context, bun.IDB) error) error {
+	return fn(ctx, nil)
+}
+
+type fakeIdempotencyKeyRepository struct {
+	keys map[string]entity.IdempotencyKey
+}
+
+func (f *fakeIdempotencyKeyRepository) Get(ctx context.Context, db bun.IDB, principalId string, key string) (entity.IdempotencyKey, error) {
+	idempotencyKey, ok := f.keys[principalId+key]
+	if !ok {
+		return entity.IdempotencyKey{}, value.ErrNotFound
+	}
+	return idempotencyKey, nil
+}
+
+func (f *fakeIdempotencyKeyRepository) Create(ctx context.Context, db bun.IDB, idempotencyKey entity.IdempotencyKey) (bool, error) {
+	if _, ok := f.keys[idempotencyKey.PrincipalId+idempotencyKey.Key]; ok {
+		return false, nil
+	}
+	f.keys[idempotencyKey.PrincipalId+idempotencyKey.Key] = idempotencyKey
+	return true, nil
+}
+
+func (f *fakeIdempotencyKeyRepository) Update(ctx context.Context, db bun.IDB, idempotencyKey entity.IdempotencyKey) error {
+	f.keys[idempotencyKey.PrincipalId+idempotencyKey.Key] = idempotencyKey
+	return nil
+}
+
+func (f *fakeIdempotencyKeyRepository) TakeOver(ctx context.Context, db bun.IDB, idempotencyKey entity.IdempotencyKey, leaseExpireTime time.Time) (bool, error) {
+	stored, ok := f.keys[idempotencyKey.PrincipalId+idempotencyKey.Key]
+	if !ok || stored.Completed() || !stored.LeaseExpireTime.Equal(idempotencyKey.LeaseExpireTime) {
+		return false, nil
+	}
+	stored.LeaseExpireTime = leaseExpireTime
+	f.keys[idempotencyKey.PrincipalId+idempotencyKey.Key] = stored
+	return true, nil
+}
+
+func (f *fakeIdempotencyKeyRepository) Delete(ctx context.Context, db bun.IDB, principalId string, key string) error {
+	delete(f.keys, principalId+key)
+	return nil
+}
+
+func (f *fakeIdempotencyKeyRepository) DeleteExpired(ctx context.Context, db bun.IDB, t time.Time) (int, error) {
+	return 0, nil
+}
+
+func newTestApp(t *testing.T) (idempotencyApp, *fakeIdempotencyKeyRepository) {
+	repo := &fakeIdempotencyKeyRepository{keys: map[string]entity.IdempotencyKey{}}
+	app, err := NewIdempotencyApp(
+		WithTransactor(fakeTransactor{}),
+		WithIdempotencyKeyRepository(repo),
+		WithTTL(time.Hour),
+		WithCleanupInterval(time.Minute),
+		WithLease(time.Minute),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return app, repo
+}
+
+func TestBegin(t *testing.T) {
+	requestTime := time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("in progress key within lease", func(t *testing.T) {
+		app, _ := newTestApp(t)
+		ctx := utils.SetRequestTime(context.Background(), requestTime)
+
+		if _, created, err := app.Begin(ctx, "principal", "key", "POST /taxicall", "hash"); err != nil || !created {
+			t.Fatalf("expected key to be created, got %v, %v", created, err)
+		}
+
+		ctx = utils.SetRequestTime(context.Background(), requestTime.Add(30*time.Second))
+		idempotencyKey, created, err := app.Begin(ctx, "principal", "key", "POST /taxicall", "hash")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if created || idempotencyKey.Completed() {
+			t.Errorf("expected in progress key, got created: %v, completed: %v", created, idempotencyKey.Completed())
+		}
+	})
+
+	t.Run("abandoned key is taken over", func(t *testing.T) {
+		app, repo := newTestApp(t)
+		ctx := utils.SetRequestTime(context.Background(), requestTime)
+
+		if _, _, err := app.Begin(ctx, "principal", "key", "POST /taxicall", "hash"); err != nil {
+			t.Fatal(err)
+		}
+
+		retryTime := requestTime.Add(2 * time.Minute)
+		ctx = utils.SetRequestTime(context.Background(), retryTime)
+		idempotencyKey, created, err := app.Begin(ctx, "principal", "key", "POST /taxicall", "hash")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !created {
+			t.Fatal("expected abandoned key to be taken over")
+		}
+		if expected := retryTime.Add(time.Minute); !idempotencyKey.LeaseExpireTime.Equal(expected) {
+			t.Errorf("expected lease renewed to %v, got %v", expected, idempotencyKey.LeaseExpireTime)
+		}
+		if stored := repo.keys["principalkey"]; !stored.CreateTime.Equal(requestTime) {
+			t.Errorf("expected key of the first request to be kept, got create time %v", stored.CreateTime)
+		}
+	})
+
+	t.Run("completed key is not taken over", func(t *testing.T) {
+		app, _ := newTestApp(t)
+		ctx := utils.SetRequestTime(context.Background(), requestTime)
+
+		idempotencyKey, _, err := app.Begin(ctx, "principal", "key", "POST /taxicall", "hash")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := app.Complete(ctx, idempotencyKey, 200, []byte("{}")); err != nil {
+			t.Fatal(err)
+		}
+
+		ctx = utils.SetRequestTime(context.Background(), requestTime.Add(2*time.Minute))
+		idempotencyKey, created, err := app.Begin(ctx, "principal", "key", "POST /taxicall", "hash")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if created || idempotencyKey.ResponseStatus != 200 {
+			t.Errorf("expected stored response to be replayed, got created: %v, status: %d", created, idempotencyKey.ResponseStatus)
+		}
+	})
+
+	t.Run("different request with the same key", func(t *testing.T) {
+		app, _ := newTestApp(t)
+		ctx := utils.SetRequestTime(context.Background(), requestTime)
+
+		if _, _, err := app.Begin(ctx, "principal", "key", "POST /taxicall", "hash"); err != nil {
+			t.Fatal(err)
+		}
+
+		ctx = utils.SetRequestTime(context.Background(), requestTime.Add(2*time.Minute))
+		_, _, err := app.Begin(ctx, "principal", "key", "POST /taxicall", "other")
+		if !errors.Is(err, value.ErrIdempotencyKeyMismatch) {
+			t.Errorf("expected key mismatch error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package idempotency
+
+import (
+	"errors"
+	"time"
+
+	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/repository"
+)
+
+type idempotencyAppOption func(*idempotencyApp)
+
+func WithTransactor(transactor app.Transactor) idempotencyAppOption {
+	return func(ia *idempotencyApp) {
+		ia.Transactor = transactor
+	}
+}
+
+func WithIdempotencyKeyRepository(repo repository.IdempotencyKeyRepository) idempotencyAppOption {
+	return func(ia *idempotencyApp) {
+		ia.repository.idempotencyKey = repo
+	}
+}
+
+func WithTTL(ttl time.Duration) idempotencyAppOption {
+	return func(ia *idempotencyApp) {
+		ia.conf.ttl = ttl
+	}
+}
+
+func WithCleanupInterval(cleanupInterval time.Duration) idempotencyAppOption {
+	return func(ia *idempotencyApp) {
+		ia.conf.cleanupInterval = cleanupInterval
+	}
+}
+
+func WithLease(lease time.Duration) idempotencyAppOption {
+	return func(ia *idempotencyApp) {
+		ia.conf.lease = lease
+	}
+}
+
+func (i idempotencyApp) validateApp() error {
+	if i.Transactor == nil {
+		return errors.New("idempotency app need transactor")
+	}
+
+	if i.repository.idempotencyKey == nil {
+		return errors.New("idempotency app need idempotency key repository")
+	}
+
+	if i.conf.ttl < time.Minute {
+		return errors.New("idempotency app required at least 1 minute ttl")
+	}
+
+	if i.conf.lease < time.Second || i.conf.lease >= i.conf.ttl {
+		return errors.New("idempotency app required at least 1 second lease, which is shorter than ttl")
+	}
+
+	if i.conf.cleanupInterval < time.Second {
+		return errors.New("idempotency app required at least 1 second cleanup interval")
+	}
+
+	return nil
+}
+
+func NewIdempotencyApp(opts ...idempotencyAppOption) (idempotencyApp, error) {
+	app := idempotencyApp{
+		stopCh: make(chan struct{}),
+		waitCh: make(chan struct{}, 1),
+	}
+
+	for _, opt := range opts {
+		opt(&app)
+	}
+
+	return app, app.validateApp()
+}
@@ -0,0 +1,58 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/taco-labs/taco/go/app"
+
+	"github.com/taco-labs/taco/go/utils"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+func (i idempotencyApp) Start(ctx context.Context) error {
+	go i.loop(ctx)
+	return nil
+}
+
+func (i idempotencyApp) Stop(ctx context.Context) error {
+	close(i.stopCh)
+	select {
+	case <-i.waitCh:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("app.idempotency.Stop: error while wait cleanup: %w", ctx.Err())
+	}
+}
+
+func (i idempotencyApp) loop(ctx context.Context) {
+	stopCtx, cancel := app.WithStop(ctx, i.stopCh)
+	defer cancel()
+
+	ticker := time.NewTicker(i.conf.cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCtx.Done():
+			utils.GetLogger(ctx).Info("shutting down idempotency key cleaner")
+			i.waitCh <- struct{}{}
+			return
+		case <-ticker.C:
+			if err := i.cleanup(ctx); err != nil {
+				utils.GetLogger(ctx).Error("error while cleanup expired idempotency keys", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (i idempotencyApp) cleanup(ctx context.Context) error {
+	return i.Run(ctx, func(ctx context.Context, db bun.IDB) error {
+		if _, err := i.repository.idempotencyKey.DeleteExpired(ctx, db, time.Now()); err != nil {
+			return fmt.Errorf("app.idempotency.cleanup: error while delete expired idempotency keys: %w", err)
+		}
+		return nil
+	})
+}
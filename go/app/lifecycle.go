@@ -0,0 +1,136 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/taco-labs/taco/go/utils"
+	"go.uber.org/zap"
+)
+
+// Runnable is a long running component managed by LifecycleManager.
+// Start must not block. Stop blocks until in-flight works are drained or the context is done.
+type Runnable interface {
+	Start(context.Context) error
+	Stop(context.Context) error
+}
+
+type namedRunnable struct {
+	name     string
+	runnable Runnable
+}
+
+type lifecycleErrors []error
+
+func (l lifecycleErrors) Error() string {
+	messages := make([]string, 0, len(l))
+	for _, err := range l {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "\n")
+}
+
+// LifecycleManager starts runnables in registered order and stops them in reverse order,
+// so that components are stopped before components they depend on
+type LifecycleManager struct {
+	runnables   []namedRunnable
+	started     []namedRunnable
+	stopTimeout time.Duration
+}
+
+func (l *LifecycleManager) Add(name string, runnable Runnable) {
+	l.runnables = append(l.runnables, namedRunnable{name: name, runnable: runnable})
+}
+
+// Start stops already started runnables if any of runnables fails to start
+func (l *LifecycleManager) Start(ctx context.Context) error {
+	for _, r := range l.runnables {
+		if err := r.runnable.Start(ctx); err != nil {
+			startErr := fmt.Errorf("app.lifecycle.Start: error while start %s: %w", r.name, err)
+			if stopErr := l.Stop(); stopErr != nil {
+				return lifecycleErrors{startErr, stopErr}
+			}
+			return startErr
+		}
+		utils.GetLogger(ctx).Info("started", zap.String("runnable", r.name))
+		l.started = append(l.started, r)
+	}
+	return nil
+}
+
+// Stop stops started runnables within stop timeout, errors of all runnables are aggregated
+func (l *LifecycleManager) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), l.stopTimeout)
+	defer cancel()
+
+	var errs lifecycleErrors
+	for idx := len(l.started) - 1; idx >= 0; idx-- {
+		r := l.started[idx]
+		if err := r.runnable.Stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("app.lifecycle.Stop: error while stop %s: %w", r.name, err))
+			continue
+		}
+		utils.GetLogger(ctx).Info("stopped", zap.String("runnable", r.name))
+	}
+	l.started = nil
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func NewLifecycleManager(stopTimeout time.Duration) *LifecycleManager {
+	return &LifecycleManager{
+		stopTimeout: stopTimeout,
+	}
+}
+
+type server interface {
+	Run(context.Context) error
+	Stop(context.Context) error
+}
+
+type serverRunnable struct {
+	name   string
+	server server
+}
+
+func (s serverRunnable) Start(ctx context.Context) error {
+	go func() {
+		if err := s.server.Run(ctx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			utils.GetLogger(ctx).Error("server stopped unexpectedly", zap.String("server", s.name), zap.Error(err))
+		}
+	}()
+	return nil
+}
+
+func (s serverRunnable) Stop(ctx context.Context) error {
+	return s.server.Stop(ctx)
+}
+
+// NewServerRunnable adapts server which blocks on Run to Runnable
+func NewServerRunnable(name string, server server) Runnable {
+	return serverRunnable{
+		name:   name,
+		server: server,
+	}
+}
+
+// WithStop returns context which is cancelled when stop channel is closed.
+// Workers wait for new works with the returned context, and handle in-flight works with the original context to drain them on stop.
+func WithStop(ctx context.Context, stopCh <-chan struct{}) (context.Context, context.CancelFunc) {
+	stopCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-stopCh:
+			cancel()
+		case <-stopCtx.Done():
+		}
+	}()
+	return stopCtx, cancel
+}
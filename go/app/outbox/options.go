@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/domain/value/enum"
 	"github.com/taco-labs/taco/go/repository"
 	"github.com/taco-labs/taco/go/service"
 )
@@ -22,12 +23,32 @@ func WithEventRepository(repo repository.EventRepository) outboxOpts {
 	}
 }
 
+func WithDeadLetterRepository(repo repository.DeadLetterRepository) outboxOpts {
+	return func(oa *outboxApp) {
+		oa.repository.deadLetter = repo
+	}
+}
+
 func WithEventPublishService(svc service.EventPublishService) outboxOpts {
 	return func(oa *outboxApp) {
 		oa.service.eventPub = svc
 	}
 }
 
+// WithName sets name of the relay, which is used as metric label
+func WithName(name string) outboxOpts {
+	return func(oa *outboxApp) {
+		oa.conf.name = name
+	}
+}
+
+// WithTopic sets topic of the publisher, which is used for dead letter of unpublishable event
+func WithTopic(topic enum.EventTopic) outboxOpts {
+	return func(oa *outboxApp) {
+		oa.conf.topic = topic
+	}
+}
+
 func WithTargetEventUirs(uris []string) outboxOpts {
 	return func(oa *outboxApp) {
 		oa.conf.targetEventUris = uris
@@ -7,100 +7,222 @@ import (
 	"time"
 
 	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/taco-labs/taco/go/metric"
 	"github.com/taco-labs/taco/go/repository"
 	"github.com/taco-labs/taco/go/service"
+	"github.com/taco-labs/taco/go/tracing"
+	"github.com/taco-labs/taco/go/utils"
 	"github.com/uptrace/bun"
+	"go.uber.org/zap"
 )
 
 type outboxApp struct {
 	app.Transactor
+	stopCh     chan struct{}
 	waitCh     chan struct{}
 	repository struct {
-		event repository.EventRepository
+		event      repository.EventRepository
+		deadLetter repository.DeadLetterRepository
 	}
 	service struct {
 		eventPub service.EventPublishService
 	}
 	conf struct {
+		name            string
+		topic           enum.EventTopic
 		targetEventUris []string
-		pollInterval    time.Duration // TODO(taekkyeom) exponential backoff for error case
-		maxMessages     int           // TODO (taekyeom) adaptive message batch sizes
+		pollInterval    time.Duration
+		maxMessages     int // Upper bound of adaptive batch size
 	}
 }
 
-func (o *outboxApp) Start(ctx context.Context) error {
+func (o outboxApp) Start(ctx context.Context) error {
 	go o.loop(ctx)
 	return nil
 }
 
-func (o outboxApp) Shuwdown() error {
-	<-o.waitCh
-	return nil
+// Stop stops claiming new batch and waits for in-flight batch to be committed
+func (o outboxApp) Stop(ctx context.Context) error {
+	close(o.stopCh)
+	select {
+	case <-o.waitCh:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("app.outbox.Stop: error while drain in-flight batch: %w", ctx.Err())
+	}
 }
 
-func (o outboxApp) loop(ctx context.Context) error {
+// Backoff for consecutive failures of publishing, max retry count is not used since each event is dead lettered by its own failure count
+var errorRetryPolicy = value.RetryPolicy{
+	BaseDelay: time.Second,
+	MaxDelay:  time.Minute,
+}
+
+const minBatchSize = 1
+
+// Event failed to be published for max publish failure count is moved to dead letter, so that it does not block following events
+const maxPublishFailureCount = 5
+
+func (o outboxApp) loop(ctx context.Context) {
+	stopCtx, cancel := app.WithStop(ctx, o.stopCh)
+	defer cancel()
+
 	timer := time.NewTimer(0 * time.Second)
+	defer timer.Stop()
+
+	batchSize := o.conf.maxMessages
+	failures := 0
 	for {
 		select {
+		case <-stopCtx.Done():
+			utils.GetLogger(ctx).Info("shutting down outbox relay", zap.String("relay", o.conf.name))
+			o.waitCh <- struct{}{}
+			return
 		case <-timer.C:
-			err := o.sendBestAffort(ctx)
+			var err error
+			batchSize, err = o.sendBestAffort(ctx, stopCtx, batchSize)
+			o.observeBacklog(ctx)
 			if err != nil {
-				// TODO (taekyeom) logging
+				utils.GetLogger(ctx).Error("error while relay events",
+					zap.String("relay", o.conf.name), zap.Int("batch_size", batchSize), zap.Int("failures", failures), zap.Error(err))
+				timer.Reset(o.conf.pollInterval + errorRetryPolicy.Backoff(failures))
+				failures++
+				continue
 			}
+			failures = 0
 			timer.Reset(o.conf.pollInterval)
 		}
 	}
 }
 
-func (o outboxApp) sendBestAffort(ctx context.Context) error {
+// sendBestAffort sends batches until events are drained and returns adjusted batch size.
+// Batch size grows while batches are full and shrinks on failure, so that failing publisher holds fewer events locked
+func (o outboxApp) sendBestAffort(ctx context.Context, stopCtx context.Context, batchSize int) (int, error) {
 	for {
 		select {
-		case <-ctx.Done():
-			o.waitCh <- struct{}{}
-			return nil
+		case <-stopCtx.Done():
+			return batchSize, nil
 		default:
-			nonEmpty, err := o.sendMessageBatch(ctx)
+			result, err := o.sendMessageBatch(ctx, batchSize)
 			if err != nil {
-				return err
+				if batchSize /= 2; batchSize < minBatchSize {
+					batchSize = minBatchSize
+				}
+				return batchSize, err
 			}
-			if !nonEmpty {
-				return nil
+			if result.claimed < batchSize {
+				return batchSize, nil
+			}
+			if batchSize *= 2; batchSize > o.conf.maxMessages {
+				batchSize = o.conf.maxMessages
 			}
 		}
 	}
 }
 
-func (o outboxApp) sendMessageBatch(ctx context.Context) (bool, error) {
-	nonEmpty := true
+type batchResult struct {
+	claimed      int
+	published    int
+	deadLettered int
+}
+
+// sendMessageBatch claims events with row lock, so that events are not published twice by concurrent relays.
+// Published events are committed even if some of the batch failed, failed events are sent again on next batch
+// until max publish failure count, then moved to dead letter within the same transaction
+func (o outboxApp) sendMessageBatch(ctx context.Context, batchSize int) (batchResult, error) {
+	result := batchResult{}
+	var publishErr error
+
 	err := o.Run(ctx, func(ctx context.Context, i bun.IDB) error {
-		events, err := o.repository.event.BatchGet(ctx, i, o.conf.targetEventUris, o.conf.maxMessages)
+		events, err := o.repository.event.BatchGet(ctx, i, o.conf.targetEventUris, batchSize)
 		if err != nil {
-			return fmt.Errorf("app.outbox.loop: error while batch get events: %w", err)
+			return fmt.Errorf("app.outbox.sendMessageBatch: error while batch get events: %w", err)
 		}
+		result.claimed = len(events)
 
-		if len(events) == 0 {
-			nonEmpty = false
-			return nil
+		committed := make([]entity.Event, 0, len(events))
+		failed := []entity.Event{}
+		for _, event := range events {
+			start := time.Now()
+			err := o.publish(ctx, event)
+			metric.OutboxPublishDuration.WithLabelValues(o.conf.name, metric.Result(err)).Observe(time.Since(start).Seconds())
+			if err == nil {
+				result.published++
+				committed = append(committed, event)
+				continue
+			}
+
+			publishErr = err
+			if event.PublishFailureCount+1 < maxPublishFailureCount {
+				failed = append(failed, event)
+				continue
+			}
+
+			deadLetter := entity.NewDeadLetter(o.conf.topic, event, err, utils.GetRequestTimeOrNow(ctx))
+			if err := o.repository.deadLetter.Create(ctx, i, deadLetter); err != nil {
+				return fmt.Errorf("app.outbox.sendMessageBatch: error while create dead letter: %w", err)
+			}
+			utils.GetLogger(ctx).Error("event is moved to dead letter after repeated publish failures",
+				zap.String("relay", o.conf.name), zap.String("message_id", event.MessageId), zap.String("event_uri", event.EventUri),
+				zap.String("dead_letter_id", deadLetter.Id), zap.Error(err))
+			result.deadLettered++
+			committed = append(committed, event)
 		}
 
-		for _, event := range events {
-			if err := o.service.eventPub.SendMessage(ctx, event); err != nil {
-				return fmt.Errorf("app.outbox.sendMessageBatch: error while send event: %w", err)
+		if len(failed) > 0 {
+			if err = o.repository.event.BatchIncreasePublishFailureCount(ctx, i, failed); err != nil {
+				return fmt.Errorf("app.outbox.sendMessageBatch: error while increase publish failure count: %w", err)
 			}
 		}
 
-		if err = o.repository.event.BatchCommit(ctx, i, events); err != nil {
-			return fmt.Errorf("app.outbox.loop: error while batch commit events: %w", err)
+		if len(committed) == 0 {
+			return nil
+		}
+
+		if err = o.repository.event.BatchCommit(ctx, i, committed); err != nil {
+			return fmt.Errorf("app.outbox.sendMessageBatch: error while batch commit events: %w", err)
 		}
 
 		return nil
 	})
 
 	if err != nil {
-		return false, err
+		return batchResult{}, err
+	}
+
+	if publishErr != nil {
+		return result, fmt.Errorf("app.outbox.sendMessageBatch: failed to send %d of %d events (%d dead lettered): %w",
+			result.claimed-result.published, result.claimed, result.deadLettered, publishErr)
+	}
+
+	return result, nil
+}
+
+// publish sends the event within producer span, which continues the trace of the span created the event
+func (o outboxApp) publish(ctx context.Context, event entity.Event) (err error) {
+	ctx, span := tracing.StartProducer(ctx, fmt.Sprintf("outbox.publish %s", event.EventUri), event)
+	defer func() { tracing.End(span, err) }()
+
+	return o.service.eventPub.SendMessage(ctx, event)
+}
+
+// observeBacklog reports events left after the batches, including events claimed by other relays
+func (o outboxApp) observeBacklog(ctx context.Context) {
+	var backlog int
+	err := o.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		count, err := o.repository.event.Count(ctx, i, o.conf.targetEventUris)
+		backlog = count
+		return err
+	})
+	if err != nil {
+		utils.GetLogger(ctx).Warn("error while count backlog", zap.String("relay", o.conf.name), zap.Error(err))
+		return
 	}
 
-	return nonEmpty, nil
+	metric.OutboxBacklog.WithLabelValues(o.conf.name).Set(float64(backlog))
 }
 
 func (o outboxApp) validateApp() error {
@@ -112,10 +234,22 @@ func (o outboxApp) validateApp() error {
 		return errors.New("outbox app need event repository")
 	}
 
+	if o.repository.deadLetter == nil {
+		return errors.New("outbox app need dead letter repository")
+	}
+
 	if o.service.eventPub == nil {
 		return errors.New("outbox app need event publisher app")
 	}
 
+	if o.conf.name == "" {
+		return errors.New("outbox app need name")
+	}
+
+	if o.conf.topic == "" || o.conf.topic == enum.EventTopic_UNKNOWN {
+		return errors.New("outbox app need topic")
+	}
+
 	if len(o.conf.targetEventUris) == 0 {
 		return errors.New("outbox app need target event uris")
 	}
@@ -124,7 +258,7 @@ func (o outboxApp) validateApp() error {
 		return errors.New("outbox app required at least 5 microseconds poll interval")
 	}
 
-	if o.conf.maxMessages > 100 || o.conf.maxMessages < 0 {
+	if o.conf.maxMessages > 100 || o.conf.maxMessages < 1 {
 		return errors.New("outbox's max messages should between 1 ~ 100")
 	}
 
@@ -133,7 +267,8 @@ func (o outboxApp) validateApp() error {
 
 func NewOutboxApp(opts ...outboxOpts) (outboxApp, error) {
 	app := outboxApp{
-		waitCh: make(chan struct{}),
+		stopCh: make(chan struct{}),
+		waitCh: make(chan struct{}, 1),
 	}
 
 	for _, opt := range opts {
@@ -0,0 +1,114 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/taco-labs/taco/go/repository"
+	"github.com/uptrace/bun"
+)
+
+type fakeTransactor struct{}
+
+func (f fakeTransactor) Run(ctx context.Context, fn func(context.Context, bun.IDB) error) error {
+	return fn(ctx, nil)
+}
+
+func (f fakeTransactor) RunWithNonRollbackError(ctx context.Context, _ error, fn func(context.Context, bun.IDB) error) error {
+	return fn(ctx, nil)
+}
+
+type fakeEventRepository struct {
+	repository.EventRepository
+
+	events    []entity.Event
+	committed []entity.Event
+	increased []entity.Event
+}
+
+func (f *fakeEventRepository) BatchGet(ctx context.Context, db bun.IDB, eventUris []string, maxSize int) ([]entity.Event, error) {
+	return f.events, nil
+}
+
+func (f *fakeEventRepository) BatchCommit(ctx context.Context, db bun.IDB, events []entity.Event) error {
+	f.committed = append(f.committed, events...)
+	return nil
+}
+
+func (f *fakeEventRepository) BatchIncreasePublishFailureCount(ctx context.Context, db bun.IDB, events []entity.Event) error {
+	f.increased = append(f.increased, events...)
+	return nil
+}
+
+type fakeDeadLetterRepository struct {
+	repository.DeadLetterRepository
+
+	deadLetters []entity.DeadLetter
+}
+
+func (f *fakeDeadLetterRepository) Create(ctx context.Context, db bun.IDB, deadLetter entity.DeadLetter) error {
+	f.deadLetters = append(f.deadLetters, deadLetter)
+	return nil
+}
+
+// fakeEventPublishService fails to publish events of the given message ids
+type fakeEventPublishService struct {
+	failures map[string]struct{}
+}
+
+func (f fakeEventPublishService) SendMessage(ctx context.Context, event entity.Event) error {
+	if _, ok := f.failures[event.MessageId]; ok {
+		return errors.New("message too large")
+	}
+	return nil
+}
+
+func TestSendMessageBatch_DeadLetterAfterRepeatedFailures(t *testing.T) {
+	eventRepo := &fakeEventRepository{
+		events: []entity.Event{
+			{MessageId: "exhausted", EventUri: "uri", PublishFailureCount: maxPublishFailureCount - 1},
+			{MessageId: "failed", EventUri: "uri", PublishFailureCount: 1},
+			{MessageId: "published", EventUri: "uri"},
+		},
+	}
+	deadLetterRepo := &fakeDeadLetterRepository{}
+
+	app, err := NewOutboxApp(
+		WithTransactor(fakeTransactor{}),
+		WithEventRepository(eventRepo),
+		WithDeadLetterRepository(deadLetterRepo),
+		WithEventPublishService(fakeEventPublishService{
+			failures: map[string]struct{}{"exhausted": {}, "failed": {}},
+		}),
+		WithName("test"),
+		WithTopic(enum.EventTopic_TAXICALL),
+		WithTargetEventUirs([]string{"uri"}),
+		WithPollInterval(time.Second),
+		WithMaxMessages(10),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := app.sendMessageBatch(context.Background(), 10)
+	if err == nil {
+		t.Error("expected publish error to be reported")
+	}
+	if result.published != 1 || result.deadLettered != 1 {
+		t.Errorf("expected 1 published & 1 dead lettered, got %+v", result)
+	}
+
+	if len(deadLetterRepo.deadLetters) != 1 || deadLetterRepo.deadLetters[0].Topic != enum.EventTopic_TAXICALL {
+		t.Fatalf("expected exhausted event to be dead lettered, got %+v", deadLetterRepo.deadLetters)
+	}
+	if len(eventRepo.increased) != 1 || eventRepo.increased[0].MessageId != "failed" {
+		t.Errorf("expected failure count of failed event to be increased, got %+v", eventRepo.increased)
+	}
+	if len(eventRepo.committed) != 2 || eventRepo.committed[0].MessageId != "exhausted" || eventRepo.committed[1].MessageId != "published" {
+		t.Errorf("expected published & dead lettered events to be removed from outbox, got %+v", eventRepo.committed)
+	}
+}
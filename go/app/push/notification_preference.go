@@ -0,0 +1,105 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/request"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/taco-labs/taco/go/utils"
+	"github.com/uptrace/bun"
+)
+
+func (t taxiCallPushApp) GetNotificationPreference(ctx context.Context, principalId string) (entity.NotificationPreference, error) {
+	var preference entity.NotificationPreference
+	var err error
+
+	err = t.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		preference, err = t.getNotificationPreference(ctx, i, principalId)
+		if err != nil {
+			return fmt.Errorf("app.push.GetNotificationPreference: error while get notification preference: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return entity.NotificationPreference{}, err
+	}
+
+	return preference, nil
+}
+
+func (t taxiCallPushApp) UpdateNotificationPreference(ctx context.Context, req request.UpdateNotificationPreferenceRequest) (entity.NotificationPreference, error) {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	if err := req.Validate(); err != nil {
+		return entity.NotificationPreference{}, fmt.Errorf("app.push.UpdateNotificationPreference: invalid request: %w", err)
+	}
+
+	var preference entity.NotificationPreference
+	var err error
+
+	err = t.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		preference, err = t.getNotificationPreference(ctx, i, req.PrincipalId)
+		if err != nil {
+			return fmt.Errorf("app.push.UpdateNotificationPreference: error while get notification preference: %w", err)
+		}
+
+		// Every marketing opt-in & opt-out should be recorded with its time
+		if preference.MarketingAgreed != req.MarketingAgreed {
+			consent := entity.MarketingConsent{
+				Id:          utils.MustNewUUID(),
+				PrincipalId: req.PrincipalId,
+				Agreed:      req.MarketingAgreed,
+				CreateTime:  requestTime,
+			}
+			if err := t.repository.notificationPreference.CreateMarketingConsent(ctx, i, consent); err != nil {
+				return fmt.Errorf("app.push.UpdateNotificationPreference: error while create marketing consent: %w", err)
+			}
+
+			preference.MarketingAgreed = req.MarketingAgreed
+			if req.MarketingAgreed {
+				preference.MarketingAgreeTime = requestTime
+			} else {
+				preference.MarketingAgreeTime = time.Time{}
+			}
+		}
+
+		preference.ProgressEnabled = req.ProgressEnabled
+		preference.ProgressChannel = enum.NotificationChannelFromString(req.ProgressChannel)
+		preference.MarketingChannel = enum.NotificationChannelFromString(req.MarketingChannel)
+		preference.QuietHoursEnabled = req.QuietHoursEnabled
+		preference.QuietStartHour = req.QuietStartHour
+		preference.QuietEndHour = req.QuietEndHour
+		preference.UpdateTime = requestTime
+
+		if err := t.repository.notificationPreference.Upsert(ctx, i, preference); err != nil {
+			return fmt.Errorf("app.push.UpdateNotificationPreference: error while upsert notification preference: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return entity.NotificationPreference{}, err
+	}
+
+	return preference, nil
+}
+
+// getNotificationPreference returns default preference if principal never updated it
+func (t taxiCallPushApp) getNotificationPreference(ctx context.Context, db bun.IDB, principalId string) (entity.NotificationPreference, error) {
+	preference, err := t.repository.notificationPreference.Get(ctx, db, principalId)
+	if errors.Is(err, value.ErrNotFound) {
+		return entity.NewDefaultNotificationPreference(principalId, utils.GetRequestTimeOrNow(ctx)), nil
+	}
+	if err != nil {
+		return entity.NotificationPreference{}, err
+	}
+
+	return preference, nil
+}
@@ -22,6 +22,42 @@ func WithPushTokenRepository(repo repository.PushTokenRepository) pushAppOption
 	}
 }
 
+func WithUserRepository(repo repository.UserRepository) pushAppOption {
+	return func(tcpa *taxiCallPushApp) {
+		tcpa.repository.user = repo
+	}
+}
+
+func WithDriverRepository(repo repository.DriverRepository) pushAppOption {
+	return func(tcpa *taxiCallPushApp) {
+		tcpa.repository.driver = repo
+	}
+}
+
+func WithNotificationPreferenceRepository(repo repository.NotificationPreferenceRepository) pushAppOption {
+	return func(tcpa *taxiCallPushApp) {
+		tcpa.repository.notificationPreference = repo
+	}
+}
+
+func WithConcurrency(concurrency int) pushAppOption {
+	return func(tcpa *taxiCallPushApp) {
+		tcpa.concurrency = concurrency
+	}
+}
+
+func WithTemplateRegistry(templates TemplateRegistry) pushAppOption {
+	return func(tcpa *taxiCallPushApp) {
+		tcpa.templates = templates
+	}
+}
+
+func WithSmsSenderService(svc service.SmsSenderService) pushAppOption {
+	return func(tcpa *taxiCallPushApp) {
+		tcpa.service.smsSender = svc
+	}
+}
+
 func WithRouteService(svc service.MapRouteService) pushAppOption {
 	return func(tcpa *taxiCallPushApp) {
 		tcpa.service.route = svc
@@ -34,6 +70,12 @@ func WithNotificationService(svc service.NotificationService) pushAppOption {
 	}
 }
 
+func WithRealtimeService(svc service.RealtimeService) pushAppOption {
+	return func(tcpa *taxiCallPushApp) {
+		tcpa.service.realtime = svc
+	}
+}
+
 func WithEventPublisherService(svc service.EventPublishService) pushAppOption {
 	return func(tcpa *taxiCallPushApp) {
 		tcpa.service.eventPub = svc
@@ -46,6 +88,12 @@ func WithEventSubscribeService(svc service.EventSubscriptionService) pushAppOpti
 	}
 }
 
+func WithDeadLetterService(svc deadLetterServiceInterface) pushAppOption {
+	return func(tcpa *taxiCallPushApp) {
+		tcpa.service.deadLetter = svc
+	}
+}
+
 func (t taxiCallPushApp) validate() error {
 	if t.Transactor == nil {
 		return errors.New("taxi call push app need transactor")
@@ -55,6 +103,30 @@ func (t taxiCallPushApp) validate() error {
 		return errors.New("taxi call push app need push token repository")
 	}
 
+	if t.repository.user == nil {
+		return errors.New("taxi call push app need user repository")
+	}
+
+	if t.repository.driver == nil {
+		return errors.New("taxi call push app need driver repository")
+	}
+
+	if t.repository.notificationPreference == nil {
+		return errors.New("taxi call push app need notification preference repository")
+	}
+
+	if t.concurrency < 1 {
+		return errors.New("taxi call push app need positive concurrency")
+	}
+
+	if t.templates.templates == nil {
+		return errors.New("taxi call push app need template registry")
+	}
+
+	if t.service.smsSender == nil {
+		return errors.New("taxi call push app need sms sender service")
+	}
+
 	if t.service.route == nil {
 		return errors.New("taxi call push app need route service")
 	}
@@ -63,6 +135,10 @@ func (t taxiCallPushApp) validate() error {
 		return errors.New("taxi call push app need notification service")
 	}
 
+	if t.service.realtime == nil {
+		return errors.New("taxi call push app need realtime service")
+	}
+
 	if t.service.eventPub == nil {
 		return errors.New("taxi call push app need event publisher")
 	}
@@ -71,17 +147,24 @@ func (t taxiCallPushApp) validate() error {
 		return errors.New("taxi call push app need event subscriber")
 	}
 
+	if t.service.deadLetter == nil {
+		return errors.New("taxi call push app need dead letter service")
+	}
+
 	return nil
 }
 
 func NewPushApp(opts ...pushAppOption) (taxiCallPushApp, error) {
 	app := taxiCallPushApp{
-		waitCh: make(chan struct{}),
+		concurrency: 1,
+		stopCh:      make(chan struct{}),
 	}
 
 	for _, opt := range opts {
 		opt(&app)
 	}
 
+	app.waitCh = make(chan struct{}, app.concurrency)
+
 	return app, app.validate()
 }
@@ -9,24 +9,39 @@ import (
 	"github.com/taco-labs/taco/go/domain/entity"
 	"github.com/taco-labs/taco/go/domain/request"
 	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
 	"github.com/taco-labs/taco/go/repository"
 	"github.com/taco-labs/taco/go/service"
 	"github.com/taco-labs/taco/go/utils"
 	"github.com/uptrace/bun"
 )
 
+type deadLetterServiceInterface interface {
+	CreateDeadLetter(context.Context, enum.EventTopic, entity.Event, error) error
+}
+
 type taxiCallPushApp struct {
 	app.Transactor
 	repository struct {
 		pushToken repository.PushTokenRepository
+		user      repository.UserRepository
+		driver    repository.DriverRepository
+
+		notificationPreference repository.NotificationPreferenceRepository
 	}
 	service struct {
 		route        service.MapRouteService
 		notification service.NotificationService
+		realtime     service.RealtimeService
+		smsSender    service.SmsSenderService
 		eventPub     service.EventPublishService
 		eventSub     service.EventSubscriptionService
+		deadLetter   deadLetterServiceInterface
 	}
-	waitCh chan struct{}
+	templates   TemplateRegistry
+	concurrency int
+	stopCh      chan struct{}
+	waitCh      chan struct{}
 }
 
 func (t taxiCallPushApp) CreatePushToken(ctx context.Context, req request.CreatePushTokenRequest) (entity.PushToken, error) {
@@ -0,0 +1,114 @@
+package push
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/template"
+
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+)
+
+type templateType string
+
+const (
+	templateType_UserTaxiCallAccepted        templateType = "USER_TAXI_CALL_ACCEPTED"
+	templateType_UserTaxiCallFailed          templateType = "USER_TAXI_CALL_FAILED"
+	templateType_UserTaxiCallDone            templateType = "USER_TAXI_CALL_DONE"
+	templateType_UserTaxiCallToArrival       templateType = "USER_TAXI_CALL_TO_ARRIVAL"
+	templateType_UserTaxiCallDriverCancelled templateType = "USER_TAXI_CALL_DRIVER_CANCELLED"
+	templateType_DriverTaxiCallTicket        templateType = "DRIVER_TAXI_CALL_TICKET"
+	templateType_DriverTaxiCallUserCancelled templateType = "DRIVER_TAXI_CALL_USER_CANCELLED"
+	templateType_DriverTaxiCallTicketExpired templateType = "DRIVER_TAXI_CALL_TICKET_EXPIRED"
+	templateType_DriverTaxiCallForceAccepted templateType = "DRIVER_TAXI_CALL_FORCE_ACCEPTED"
+	templateType_SmsFallback                 templateType = "SMS_FALLBACK"
+)
+
+//go:embed templates.json
+var defaultTemplates []byte
+
+type messageTemplate struct {
+	title *template.Template
+	body  *template.Template
+}
+
+// TemplateRegistry renders notification message by template type and language.
+// Falls back to default language if the template of requested language does not exist.
+type TemplateRegistry struct {
+	templates map[templateType]map[enum.Language]messageTemplate
+}
+
+func (t TemplateRegistry) render(templateType templateType, language enum.Language, data interface{}) (value.NotificationMessage, error) {
+	templates, ok := t.templates[templateType]
+	if !ok {
+		return value.NotificationMessage{}, fmt.Errorf("%w: template %s not found", value.ErrInternal, templateType)
+	}
+
+	tmpl, ok := templates[language]
+	if !ok {
+		tmpl, ok = templates[enum.Language_KO]
+	}
+	if !ok {
+		return value.NotificationMessage{}, fmt.Errorf("%w: template %s (%s) not found", value.ErrInternal, templateType, language)
+	}
+
+	var title, body bytes.Buffer
+	if err := tmpl.title.Execute(&title, data); err != nil {
+		return value.NotificationMessage{}, fmt.Errorf("%w: error while render title of %s: %v", value.ErrInternal, templateType, err)
+	}
+	if err := tmpl.body.Execute(&body, data); err != nil {
+		return value.NotificationMessage{}, fmt.Errorf("%w: error while render body of %s: %v", value.ErrInternal, templateType, err)
+	}
+
+	return value.NotificationMessage{
+		Title: title.String(),
+		Body:  body.String(),
+	}, nil
+}
+
+// NewTemplateRegistry loads templates from json file of the path. Embedded default templates are used if path is empty.
+func NewTemplateRegistry(path string) (TemplateRegistry, error) {
+	content := defaultTemplates
+	if path != "" {
+		c, err := os.ReadFile(path)
+		if err != nil {
+			return TemplateRegistry{}, fmt.Errorf("error while read template file: %w", err)
+		}
+		content = c
+	}
+
+	rawTemplates := map[templateType]map[enum.Language]struct {
+		Title string `json:"title"`
+		Body  string `json:"body"`
+	}{}
+	if err := json.Unmarshal(content, &rawTemplates); err != nil {
+		return TemplateRegistry{}, fmt.Errorf("error while parse template file: %w", err)
+	}
+
+	registry := TemplateRegistry{
+		templates: make(map[templateType]map[enum.Language]messageTemplate),
+	}
+	for templateType, rawTemplatesByLanguage := range rawTemplates {
+		registry.templates[templateType] = make(map[enum.Language]messageTemplate)
+		for language, rawTemplate := range rawTemplatesByLanguage {
+			name := fmt.Sprintf("%s.%s", templateType, language)
+			title, err := template.New(name + ".title").Parse(rawTemplate.Title)
+			if err != nil {
+				return TemplateRegistry{}, fmt.Errorf("error while parse title of %s: %w", name, err)
+			}
+			body, err := template.New(name + ".body").Parse(rawTemplate.Body)
+			if err != nil {
+				return TemplateRegistry{}, fmt.Errorf("error while parse body of %s: %w", name, err)
+			}
+			registry.templates[templateType][language] = messageTemplate{
+				title: title,
+				body:  body,
+			}
+		}
+	}
+
+	return registry, nil
+}
@@ -3,47 +3,83 @@ package push
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/taco-labs/taco/go/app"
 	"github.com/taco-labs/taco/go/domain/entity"
 	"github.com/taco-labs/taco/go/domain/event/command"
 	"github.com/taco-labs/taco/go/domain/value"
 	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/taco-labs/taco/go/metric"
+	"github.com/taco-labs/taco/go/tracing"
+	"github.com/taco-labs/taco/go/utils"
 	"github.com/uptrace/bun"
+	"go.uber.org/zap"
 )
 
 func (t taxiCallPushApp) Start(ctx context.Context) error {
-	go t.loop(ctx)
+	for i := 0; i < t.concurrency; i++ {
+		go t.loop(ctx)
+	}
 	return nil
 }
 
+// Stop stops receiving new events and waits for in-flight events of all consumers to be handled
 func (t taxiCallPushApp) Stop(ctx context.Context) error {
-	<-t.waitCh
+	close(t.stopCh)
+	for i := 0; i < t.concurrency; i++ {
+		select {
+		case <-t.waitCh:
+		case <-ctx.Done():
+			return fmt.Errorf("app.taxiCallPushApp.Stop: error while drain in-flight events of %d consumers: %w", t.concurrency-i, ctx.Err())
+		}
+	}
 	return nil
 }
 
 func (t taxiCallPushApp) loop(ctx context.Context) {
+	receiveCtx, cancel := app.WithStop(ctx, t.stopCh)
+	defer cancel()
+
 	for {
 		select {
-		case <-ctx.Done():
-			fmt.Println("shutting down [Taxi Call Push Consumer] stream...")
+		case <-receiveCtx.Done():
+			utils.GetLogger(ctx).Info("shutting down taxi call push consumer")
 			t.waitCh <- struct{}{}
 			return
 		default:
-			err := t.consume(ctx)
-			if err != nil {
-				//TODO (taekyeom) logging
-				fmt.Printf("[TaxiCallPushApp.Worker] error while consume event: %+v\n", err)
-			}
+			t.consume(ctx, receiveCtx)
 		}
 	}
 }
 
-// TODO (taekyeom) parallelize
-func (t taxiCallPushApp) consume(ctx context.Context) error {
-	event, err := t.service.eventSub.GetMessage(ctx)
+// Notifications must be delivered to user, fallback to sms if push is not available
+var userSmsFallbackStates = map[enum.TaxiCallState]struct{}{
+	enum.TaxiCallState_DRIVER_TO_DEPARTURE: {},
+	enum.TaxiCallState_FAILED:              {},
+	enum.TaxiCallState_DONE:                {},
+	enum.TaxiCallState_DRIVER_CANCELLED:    {},
+}
+
+// Dispatch progress can be muted by user preference, other notifications are transactional
+var userNotificationCategories = map[enum.TaxiCallState]enum.NotificationCategory{
+	enum.TaxiCallState_Requested: enum.NotificationCategory_PROGRESS,
+}
+
+func userNotificationCategory(state enum.TaxiCallState) enum.NotificationCategory {
+	if category, ok := userNotificationCategories[state]; ok {
+		return category
+	}
+	return enum.NotificationCategory_TRANSACTIONAL
+}
+
+// consume handles an event with logger & span of the event, failed event is retried or kept as dead letter
+func (t taxiCallPushApp) consume(ctx context.Context, receiveCtx context.Context) {
+	event, err := t.service.eventSub.GetMessage(receiveCtx)
 	if err != nil {
-		return nil
+		return
 	}
 
 	// if event.RetryCount > 2 {
@@ -53,22 +89,54 @@ func (t taxiCallPushApp) consume(ctx context.Context) error {
 	// 	return nil
 	// }
 
-	defer event.Ack()
+	ctx = utils.SetEvent(ctx, event.MessageId, event.EventUri)
+	ctx, span := tracing.StartConsumer(ctx, fmt.Sprintf("push.consume %s", event.EventUri), event)
 
+	settled, err := t.handleEventWithRetry(ctx, event)
+	tracing.End(span, err)
+	if err != nil {
+		utils.GetLogger(ctx).Error("error while consume event", zap.Int("retry_count", event.RetryCount), zap.Error(err))
+	}
+
+	// Event which is neither retried nor kept as dead letter is left unacked, so that it is redelivered after visibility timeout
+	if !settled {
+		return
+	}
+	if err := event.Ack(); err != nil {
+		utils.GetLogger(ctx).Error("error while ack event", zap.Error(err))
+	}
+}
+
+// handleEventWithRetry returns false if the failed event is neither retried nor kept as dead letter
+func (t taxiCallPushApp) handleEventWithRetry(ctx context.Context, event entity.Event) (bool, error) {
+	var err error
 	switch event.EventUri {
 	case command.EventUri_UserTaxiCallNotification:
 		err = t.handleUserNotification(ctx, event)
 	case command.EventUri_DriverTaxiCallNotification:
 		err = t.handleDriverNotification(ctx, event)
+	case command.EventUri_DriverTaxiCallBulkNotification:
+		// Only failed entries are retried by the handler
+		return t.handleDriverBulkNotification(ctx, event)
 	}
 
-	// If error occurred, resend event with increased retry event count
-	if err != nil && event.RetryCount < 3 {
-		newEvent := event.NewEventWithRetry()
-		t.service.eventPub.SendMessage(ctx, newEvent)
-		return err
+	if err == nil {
+		return true, nil
 	}
-	return nil
+
+	// If error occurred, resend event with increased retry event count after backoff
+	retried, retryErr := app.RetryEvent(ctx, t.service.eventPub, event)
+	if retryErr != nil {
+		return false, fmt.Errorf("app.taxiCallPushApp.consume: error while publish retry event: %v: %w", retryErr, err)
+	}
+
+	// Retry count is exhausted, keep the event to be inspected & replayed from backoffice
+	if !retried {
+		if dlErr := t.service.deadLetter.CreateDeadLetter(ctx, enum.EventTopic_NOTIFICATION, event, err); dlErr != nil {
+			return false, fmt.Errorf("app.taxiCallPushApp.consume: error while create dead letter: %v: %w", dlErr, err)
+		}
+	}
+	return true, err
 }
 
 func (t taxiCallPushApp) handleUserNotification(ctx context.Context, event entity.Event) error {
@@ -77,27 +145,43 @@ func (t taxiCallPushApp) handleUserNotification(ctx context.Context, event entit
 	if err != nil {
 		return fmt.Errorf("app.taxiCallPushApp.handleUserNotification: erorr while unmarshal user notificaiton event: %w, %v", value.ErrInternal, err)
 	}
+	ctx = utils.SetTaxiCallRequestId(ctx, userNotificationCommand.TaxiCallRequestId)
 
-	var fcmToken string
-	err = t.Run(ctx, func(ctx context.Context, i bun.IDB) error {
-		token, err := t.repository.pushToken.Get(ctx, i, userNotificationCommand.UserId)
-		if err != nil {
-			return fmt.Errorf("app.taxiCallPushApp.handleUserNotification: error while get fcm token: %w", err)
-		}
-		fcmToken = token.FcmToken
+	fcmToken, err := t.getFcmToken(ctx, userNotificationCommand.UserId)
+	if err != nil {
+		return fmt.Errorf("app.taxiCallPushApp.handleUserNotification: error while get fcm token: %w", err)
+	}
+
+	language, err := t.getUserLanguage(ctx, userNotificationCommand.UserId)
+	if err != nil {
+		return fmt.Errorf("app.taxiCallPushApp.handleUserNotification: error while get user language: %w", err)
+	}
+
+	preference, err := t.getPreference(ctx, userNotificationCommand.UserId)
+	if err != nil {
+		return fmt.Errorf("app.taxiCallPushApp.handleUserNotification: error while get notification preference: %w", err)
+	}
+
+	taxiCallState := enum.FromTaxiCallStateString(userNotificationCommand.TaxiCallState)
+	category := userNotificationCategory(taxiCallState)
+	if !preference.Allow(category, utils.GetRequestTimeOrNow(ctx)) {
 		return nil
-	})
+	}
 
 	var notification value.Notification
-	switch enum.FromTaxiCallStateString(userNotificationCommand.TaxiCallState) {
+	switch taxiCallState {
 	case enum.TaxiCallState_Requested:
-		notification, err = t.handleUserTaxiCallRequestProgress(ctx, fcmToken, event.CreateTime, userNotificationCommand)
+		notification, err = t.handleUserTaxiCallRequestProgress(ctx, fcmToken, language, event.CreateTime, userNotificationCommand)
 	case enum.TaxiCallState_DRIVER_TO_DEPARTURE:
-		notification, err = t.handleUserTaxiCallRequestAccepted(ctx, fcmToken, event.CreateTime, userNotificationCommand)
+		notification, err = t.handleUserTaxiCallRequestAccepted(ctx, fcmToken, language, event.CreateTime, userNotificationCommand)
+	case enum.TaxiCallState_DRIVER_TO_ARRIVAL:
+		notification, err = t.handleUserTaxiCallRequestToArrival(ctx, fcmToken, language, event.CreateTime, userNotificationCommand)
+	case enum.TaxiCallState_DRIVER_CANCELLED:
+		notification, err = t.handleUserTaxiCallRequestDriverCancelled(ctx, fcmToken, language, event.CreateTime, userNotificationCommand)
 	case enum.TaxiCallState_FAILED:
-		notification, err = t.handleUserTaxiCallRequestFailed(ctx, fcmToken, event.CreateTime, userNotificationCommand)
+		notification, err = t.handleUserTaxiCallRequestFailed(ctx, fcmToken, language, event.CreateTime, userNotificationCommand)
 	case enum.TaxiCallState_DONE:
-		notification, err = t.handleUserTaxiCallRequestDone(ctx, fcmToken, event.CreateTime, userNotificationCommand)
+		notification, err = t.handleUserTaxiCallRequestDone(ctx, fcmToken, language, event.CreateTime, userNotificationCommand)
 	default:
 		return fmt.Errorf("app.taxiCallPushApp.handleUserNotification: unsupported event: %s: %w", userNotificationCommand.TaxiCallState, value.ErrInvalidOperation)
 	}
@@ -106,11 +190,38 @@ func (t taxiCallPushApp) handleUserNotification(ctx context.Context, event entit
 		return fmt.Errorf("app.taxiCallPushApp.handleUserNotification: error while handle command: %w", err)
 	}
 
-	if err := t.service.notification.SendNotification(ctx, notification); err != nil {
-		return fmt.Errorf("app.taxiCallPushApp.handleUserNotification: error while send notification: %w", err)
+	if preference.Channel(category) == enum.NotificationChannel_SMS {
+		if err := t.sendUserSms(ctx, userNotificationCommand.UserId, notification); err != nil {
+			return fmt.Errorf("app.taxiCallPushApp.handleUserNotification: error while send sms: %w", err)
+		}
+		return nil
 	}
 
-	return nil
+	// Transactional notifications must be delivered, so that sms fallback is not subject to the preference
+	_, smsFallback := userSmsFallbackStates[taxiCallState]
+
+	err = t.sendNotification(ctx, userNotificationCommand.UserId, notification, smsFallback)
+	if err == nil {
+		return nil
+	}
+
+	pushUnavailable := errors.Is(err, value.ErrPushTokenUnavailable)
+
+	// Retry transient push failure until the last attempt
+	if smsFallback && (pushUnavailable || event.RetryCount >= app.RetryPolicyOf(event).MaxRetryCount) {
+		utils.GetLogger(ctx).Info("fallback to sms", zap.Error(err))
+		if err := t.sendUserSms(ctx, userNotificationCommand.UserId, notification); err != nil {
+			return fmt.Errorf("app.taxiCallPushApp.handleUserNotification: error while send sms fallback: %w", err)
+		}
+		return nil
+	}
+
+	if pushUnavailable {
+		utils.GetLogger(ctx).Warn("notification is dropped", zap.Error(err))
+		return nil
+	}
+
+	return fmt.Errorf("app.taxiCallPushApp.handleUserNotification: error while send notification: %w", err)
 }
 
 func (t taxiCallPushApp) handleDriverNotification(ctx context.Context, event entity.Event) error {
@@ -119,32 +230,351 @@ func (t taxiCallPushApp) handleDriverNotification(ctx context.Context, event ent
 	if err != nil {
 		return fmt.Errorf("app.taxiCallPushApp.handleDriverNotification: erorr while unmarshal driver notificaiton event: %w, %v", value.ErrInternal, err)
 	}
+	ctx = utils.SetTaxiCallRequestId(ctx, driverNotificationCommand.TaxiCallRequestId)
+
+	notification, channel, ok, err := t.buildDriverNotification(ctx, event.CreateTime, driverNotificationCommand)
+	if err != nil {
+		return fmt.Errorf("app.taxiCallPushApp.handleDriverNotification: %w", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	if channel == enum.NotificationChannel_SMS {
+		if err := t.sendDriverSms(ctx, driverNotificationCommand.DriverId, notification); err != nil {
+			return fmt.Errorf("app.taxiCallPushApp.handleDriverNotification: error while send sms: %w", err)
+		}
+		return nil
+	}
+
+	err = t.sendNotification(ctx, driverNotificationCommand.DriverId, notification, false)
+	if errors.Is(err, value.ErrPushTokenUnavailable) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("app.taxiCallPushApp.handleDriverNotification: error while send notification: %w", err)
+	}
+
+	return nil
+}
+
+// handleDriverBulkNotification sends notifications which are not delivered through realtime channel with single bulk request,
+// and publishes new event only with failed entries for retry. Returns false if the failed entries are neither retried nor kept as dead letter
+func (t taxiCallPushApp) handleDriverBulkNotification(ctx context.Context, event entity.Event) (bool, error) {
+	bulkCommand := command.DriverTaxiCallBulkNotificationCommand{}
+	err := json.Unmarshal(event.Payload, &bulkCommand)
+	if err != nil {
+		return true, fmt.Errorf("app.taxiCallPushApp.handleDriverBulkNotification: erorr while unmarshal driver bulk notificaiton event: %w, %v", value.ErrInternal, err)
+	}
+
+	failed := []command.DriverTaxiCallNotificationCommand{}
+	pendingCommands := []command.DriverTaxiCallNotificationCommand{}
+	pendingNotifications := []value.Notification{}
+
+	for _, cmd := range bulkCommand.Commands {
+		notification, channel, ok, err := t.buildDriverNotification(ctx, event.CreateTime, cmd)
+		if err != nil {
+			failed = append(failed, cmd)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		if channel == enum.NotificationChannel_SMS {
+			if err := t.sendDriverSms(ctx, cmd.DriverId, notification); err != nil {
+				failed = append(failed, cmd)
+			}
+			continue
+		}
+
+		delivered, err := t.service.realtime.Send(ctx, cmd.DriverId, notification)
+		if err != nil {
+			countSendFailure(pushChannel_Realtime, err)
+			failed = append(failed, cmd)
+			continue
+		}
+		if delivered || notification.Principal == "" {
+			continue
+		}
+
+		pendingCommands = append(pendingCommands, cmd)
+		pendingNotifications = append(pendingNotifications, notification)
+	}
+
+	if len(pendingNotifications) > 0 {
+		results, err := t.service.notification.BulkSendNotification(ctx, pendingNotifications)
+		if err != nil {
+			metric.PushSendFailures.WithLabelValues(pushChannel_Fcm, failureType(err)).Add(float64(len(pendingCommands)))
+			failed = append(failed, pendingCommands...)
+		}
+		for idx, result := range results {
+			countSendFailure(pushChannel_Fcm, result)
+			if errors.Is(result, value.ErrPushTokenUnavailable) {
+				if err := t.deletePushToken(ctx, pendingCommands[idx].DriverId, pendingNotifications[idx].Principal); err != nil {
+					failed = append(failed, pendingCommands[idx])
+				}
+				continue
+			}
+			if result != nil {
+				failed = append(failed, pendingCommands[idx])
+			}
+		}
+	}
+
+	if len(failed) == 0 {
+		return true, nil
+	}
+
+	failedEvent := event
+	failedEvent.Payload, _ = json.Marshal(command.DriverTaxiCallBulkNotificationCommand{Commands: failed})
+	failedErr := fmt.Errorf("app.taxiCallPushApp.handleDriverBulkNotification: failed to send %d of %d notifications: %w",
+		len(failed), len(bulkCommand.Commands), value.ErrExternal)
+
+	retried, err := app.RetryEvent(ctx, t.service.eventPub, failedEvent)
+	if err != nil {
+		return false, fmt.Errorf("app.taxiCallPushApp.handleDriverBulkNotification: error while publish retry event: %v: %w", err, failedErr)
+	}
+	if retried {
+		return true, failedErr
+	}
+
+	// Only failed entries are kept as dead letter
+	if err := t.service.deadLetter.CreateDeadLetter(ctx, enum.EventTopic_NOTIFICATION, failedEvent, failedErr); err != nil {
+		return false, fmt.Errorf("app.taxiCallPushApp.handleDriverBulkNotification: error while create dead letter: %v: %w", err, failedErr)
+	}
+
+	return true, failedErr
+}
+
+// buildDriverNotification returns false if the notification is muted by driver's preference,
+// otherwise returns the notification with the channel chosen by driver
+func (t taxiCallPushApp) buildDriverNotification(ctx context.Context, eventTime time.Time,
+	driverNotificationCommand command.DriverTaxiCallNotificationCommand) (value.Notification, enum.NotificationChannel, bool, error) {
+	fcmToken, err := t.getFcmToken(ctx, driverNotificationCommand.DriverId)
+	if err != nil {
+		return value.Notification{}, "", false, fmt.Errorf("error while get fcm token: %w", err)
+	}
+
+	language, err := t.getDriverLanguage(ctx, driverNotificationCommand.DriverId)
+	if err != nil {
+		return value.Notification{}, "", false, fmt.Errorf("error while get driver language: %w", err)
+	}
+
+	preference, err := t.getPreference(ctx, driverNotificationCommand.DriverId)
+	if err != nil {
+		return value.Notification{}, "", false, fmt.Errorf("error while get notification preference: %w", err)
+	}
+
+	// Ticket expiration is informative, drivers can mute it
+	category := enum.NotificationCategory_TRANSACTIONAL
+	if driverNotificationCommand.TicketExpired {
+		category = enum.NotificationCategory_PROGRESS
+	}
+	if !preference.Allow(category, utils.GetRequestTimeOrNow(ctx)) {
+		return value.Notification{}, "", false, nil
+	}
+
+	var notification value.Notification
+	switch taxiCallState := enum.FromTaxiCallStateString(driverNotificationCommand.TaxiCallState); {
+	case driverNotificationCommand.TicketExpired:
+		notification, err = t.handleDriverTaxiCallTicketExpired(ctx, fcmToken, language, eventTime, driverNotificationCommand)
+	case taxiCallState == enum.TaxiCallState_Requested:
+		notification, err = t.handleDriverTaxiCallRequestTicketDistribution(ctx, fcmToken, language, eventTime, driverNotificationCommand)
+	case taxiCallState == enum.TaxiCallState_DRIVER_TO_DEPARTURE:
+		notification, err = t.handleDriverTaxiCallRequestForceAccepted(ctx, fcmToken, language, eventTime, driverNotificationCommand)
+	case taxiCallState == enum.TaxiCallState_USER_CANCELLED:
+		notification, err = t.handleDriverTaxiCallRequestUserCancelled(ctx, fcmToken, language, eventTime, driverNotificationCommand)
+	default:
+		return value.Notification{}, "", false, fmt.Errorf("unsupported event: %s: %w", driverNotificationCommand.TaxiCallState, value.ErrInvalidOperation)
+	}
+
+	if err != nil {
+		return value.Notification{}, "", false, fmt.Errorf("error while handle command: %w", err)
+	}
 
+	return notification, preference.Channel(category), true, nil
+}
+
+// getFcmToken returns empty token if the principal has not registered push token (eg. web client)
+func (t taxiCallPushApp) getFcmToken(ctx context.Context, principalId string) (string, error) {
 	var fcmToken string
-	err = t.Run(ctx, func(ctx context.Context, i bun.IDB) error {
-		token, err := t.repository.pushToken.Get(ctx, i, driverNotificationCommand.DriverId)
+	err := t.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		token, err := t.repository.pushToken.Get(ctx, i, principalId)
+		if errors.Is(err, value.ErrNotFound) {
+			return nil
+		}
 		if err != nil {
-			return fmt.Errorf("app.taxiCallPushApp.handleUserNotification: error while get fcm token: %w", err)
+			return err
 		}
 		fcmToken = token.FcmToken
 		return nil
 	})
 
-	var notification value.Notification
-	switch enum.FromTaxiCallStateString(driverNotificationCommand.TaxiCallState) {
-	case enum.TaxiCallState_Requested:
-		notification, err = t.handleDriverTaxiCallRequestTicketDistribution(ctx, fcmToken, event.CreateTime, driverNotificationCommand)
-	default:
-		return fmt.Errorf("app.taxiCallPushApp.handleDriverNotification: unsupported event: %s: %w", driverNotificationCommand.TaxiCallState, value.ErrInvalidOperation)
+	return fcmToken, err
+}
+
+// sendNotification delivers notification through realtime channel first, and fallback to FCM if the principal is not connected.
+// Critical notification is sent through FCM as well, since the client may be gone after the stream write.
+// Returns value.ErrPushTokenUnavailable if neither is available, and the invalid token is deleted.
+func (t taxiCallPushApp) sendNotification(ctx context.Context, principalId string, notification value.Notification, critical bool) error {
+	delivered, err := t.service.realtime.Send(ctx, principalId, notification)
+	if err != nil {
+		countSendFailure(pushChannel_Realtime, err)
+		return fmt.Errorf("error while send realtime notification: %w", err)
+	}
+	if delivered && !critical {
+		return nil
+	}
+
+	if notification.Principal == "" {
+		if delivered {
+			return nil
+		}
+		err := fmt.Errorf("push token not found: %w", value.ErrPushTokenUnavailable)
+		countSendFailure(pushChannel_Fcm, err)
+		return err
 	}
 
+	err = t.service.notification.SendNotification(ctx, notification)
+	countSendFailure(pushChannel_Fcm, err)
+	if errors.Is(err, value.ErrPushTokenUnavailable) {
+		if err := t.deletePushToken(ctx, principalId, notification.Principal); err != nil {
+			return fmt.Errorf("error while delete invalid push token: %w", err)
+		}
+		// Written to the stream, but the client has no other channel
+		if delivered {
+			return nil
+		}
+	}
+
+	return err
+}
+
+// deletePushToken deletes the token only if it is not renewed by client since it was read
+func (t taxiCallPushApp) deletePushToken(ctx context.Context, principalId string, fcmToken string) error {
+	return t.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		pushToken, err := t.repository.pushToken.Get(ctx, i, principalId)
+		if errors.Is(err, value.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if pushToken.FcmToken != fcmToken {
+			return nil
+		}
+
+		return t.repository.pushToken.Delete(ctx, i, pushToken)
+	})
+}
+
+func (t taxiCallPushApp) sendUserSms(ctx context.Context, userId string, notification value.Notification) error {
+	var user entity.User
+	err := t.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		u, err := t.repository.user.FindById(ctx, i, userId)
+		if err != nil {
+			return err
+		}
+		user = u
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("app.taxiCallPushApp.handleDriverNotification: error while handle command: %w", err)
+		return fmt.Errorf("error while get user: %w", err)
 	}
 
-	if err := t.service.notification.SendNotification(ctx, notification); err != nil {
-		return fmt.Errorf("app.taxiCallPushApp.handleDriverNotification: error while send notification: %w", err)
+	return t.sendSms(ctx, user.Phone, user.Language, notification)
+}
+
+func (t taxiCallPushApp) sendDriverSms(ctx context.Context, driverId string, notification value.Notification) error {
+	var driver entity.Driver
+	err := t.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		d, err := t.repository.driver.FindById(ctx, i, driverId)
+		if err != nil {
+			return err
+		}
+		driver = d
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error while get driver: %w", err)
 	}
 
-	return nil
+	return t.sendSms(ctx, driver.Phone, driver.Language, notification)
+}
+
+func (t taxiCallPushApp) sendSms(ctx context.Context, phone string, language enum.Language, notification value.Notification) error {
+	message, err := t.templates.render(templateType_SmsFallback, language, notification.Message)
+	if err != nil {
+		return fmt.Errorf("error while render sms message: %w", err)
+	}
+
+	err = t.service.smsSender.SendSms(ctx, phone, message.Body)
+	countSendFailure(pushChannel_Sms, err)
+
+	return err
+}
+
+func (t taxiCallPushApp) getPreference(ctx context.Context, principalId string) (entity.NotificationPreference, error) {
+	var preference entity.NotificationPreference
+	err := t.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		p, err := t.getNotificationPreference(ctx, i, principalId)
+		if err != nil {
+			return err
+		}
+		preference = p
+		return nil
+	})
+
+	return preference, err
+}
+
+func (t taxiCallPushApp) getUserLanguage(ctx context.Context, userId string) (enum.Language, error) {
+	var language enum.Language
+	err := t.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		user, err := t.repository.user.FindById(ctx, i, userId)
+		if err != nil {
+			return err
+		}
+		language = user.Language
+		return nil
+	})
+
+	return language, err
+}
+
+func (t taxiCallPushApp) getDriverLanguage(ctx context.Context, driverId string) (enum.Language, error) {
+	var language enum.Language
+	err := t.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		driver, err := t.repository.driver.FindById(ctx, i, driverId)
+		if err != nil {
+			return err
+		}
+		language = driver.Language
+		return nil
+	})
+
+	return language, err
+}
+
+const (
+	pushChannel_Fcm      = "fcm"
+	pushChannel_Realtime = "realtime"
+	pushChannel_Sms      = "sms"
+)
+
+func failureType(err error) string {
+	if errors.Is(err, value.ErrPushTokenUnavailable) {
+		return "token_unavailable"
+	}
+	return "error"
+}
+
+// countSendFailure counts failure of the channel, nil error is ignored
+func countSendFailure(channel string, err error) {
+	if err == nil {
+		return
+	}
+	metric.PushSendFailures.WithLabelValues(channel, failureType(err)).Inc()
 }
@@ -7,9 +7,10 @@ import (
 
 	"github.com/taco-labs/taco/go/domain/event/command"
 	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
 )
 
-func (t taxiCallPushApp) handleDriverTaxiCallRequestTicketDistribution(ctx context.Context, fcmToken string,
+func (t taxiCallPushApp) handleDriverTaxiCallRequestTicketDistribution(ctx context.Context, fcmToken string, language enum.Language,
 	eventTime time.Time, cmd command.DriverTaxiCallNotificationCommand) (value.Notification, error) {
 
 	routeBetweenDeparture, err := t.service.route.GetRoute(ctx, cmd.DriverLocation, cmd.Departure.Point)
@@ -18,9 +19,18 @@ func (t taxiCallPushApp) handleDriverTaxiCallRequestTicketDistribution(ctx conte
 			fmt.Errorf("service.TaxiCallPush.handleDriverTaxiCallRequestTicketDistribution: error while get route between driver location and departure: %w", err)
 	}
 
-	message := value.NotificationMessage{
-		Title: fmt.Sprintf("배차 요청 (약 %d분)", int(routeBetweenDeparture.ETA.Minutes())),
-		Body:  fmt.Sprintf("%s (추가 요금 %d)", cmd.Departure.Address.AddressName, cmd.AdditionalPrice),
+	message, err := t.templates.render(templateType_DriverTaxiCallTicket, language, struct {
+		EtaMinutes       int
+		DepartureAddress string
+		AdditionalPrice  int
+	}{
+		EtaMinutes:       int(routeBetweenDeparture.ETA.Minutes()),
+		DepartureAddress: cmd.Departure.Address.AddressName,
+		AdditionalPrice:  cmd.AdditionalPrice,
+	})
+	if err != nil {
+		return value.Notification{},
+			fmt.Errorf("service.TaxiCallPush.handleDriverTaxiCallRequestTicketDistribution: error while render message: %w", err)
 	}
 
 	data := map[string]string{
@@ -49,3 +59,77 @@ func (t taxiCallPushApp) handleDriverTaxiCallRequestTicketDistribution(ctx conte
 		Data:      data,
 	}, nil
 }
+
+func (t taxiCallPushApp) handleDriverTaxiCallRequestForceAccepted(ctx context.Context, fcmToken string, language enum.Language,
+	eventTime time.Time, cmd command.DriverTaxiCallNotificationCommand) (value.Notification, error) {
+
+	message, err := t.templates.render(templateType_DriverTaxiCallForceAccepted, language, struct {
+		DepartureAddress string
+	}{
+		DepartureAddress: cmd.Departure.Address.AddressName,
+	})
+	if err != nil {
+		return value.Notification{}, fmt.Errorf("app.push.handleDriverTaxiCallRequestForceAccepted: error while render message: %w", err)
+	}
+
+	data := map[string]string{
+		"taxiCallRequestId": cmd.TaxiCallRequestId,
+		"taxiCallState":     cmd.TaxiCallState,
+	}
+
+	return value.Notification{
+		Principal: fcmToken,
+		Message:   message,
+		Data:      data,
+	}, nil
+}
+
+func (t taxiCallPushApp) handleDriverTaxiCallRequestUserCancelled(ctx context.Context, fcmToken string, language enum.Language,
+	eventTime time.Time, cmd command.DriverTaxiCallNotificationCommand) (value.Notification, error) {
+
+	message, err := t.templates.render(templateType_DriverTaxiCallUserCancelled, language, struct {
+		DepartureAddress string
+	}{
+		DepartureAddress: cmd.Departure.Address.AddressName,
+	})
+	if err != nil {
+		return value.Notification{}, fmt.Errorf("app.push.handleDriverTaxiCallRequestUserCancelled: error while render message: %w", err)
+	}
+
+	data := map[string]string{
+		"taxiCallRequestId": cmd.TaxiCallRequestId,
+		"taxiCallState":     cmd.TaxiCallState,
+	}
+
+	return value.Notification{
+		Principal: fcmToken,
+		Message:   message,
+		Data:      data,
+	}, nil
+}
+
+func (t taxiCallPushApp) handleDriverTaxiCallTicketExpired(ctx context.Context, fcmToken string, language enum.Language,
+	eventTime time.Time, cmd command.DriverTaxiCallNotificationCommand) (value.Notification, error) {
+
+	message, err := t.templates.render(templateType_DriverTaxiCallTicketExpired, language, struct {
+		DepartureAddress string
+	}{
+		DepartureAddress: cmd.Departure.Address.AddressName,
+	})
+	if err != nil {
+		return value.Notification{}, fmt.Errorf("app.push.handleDriverTaxiCallTicketExpired: error while render message: %w", err)
+	}
+
+	data := map[string]string{
+		"taxiCallRequestId": cmd.TaxiCallRequestId,
+		"taxiCallState":     cmd.TaxiCallState,
+		"taxiCallTicketId":  cmd.TaxiCallTicketId,
+		"ticketExpired":     "true",
+	}
+
+	return value.Notification{
+		Principal: fcmToken,
+		Message:   message,
+		Data:      data,
+	}, nil
+}
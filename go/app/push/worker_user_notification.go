@@ -7,9 +7,10 @@ import (
 
 	"github.com/taco-labs/taco/go/domain/event/command"
 	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
 )
 
-func (t taxiCallPushApp) handleUserTaxiCallRequestProgress(ctx context.Context, fcmToken string,
+func (t taxiCallPushApp) handleUserTaxiCallRequestProgress(ctx context.Context, fcmToken string, language enum.Language,
 	eventTime time.Time, cmd command.UserTaxiCallNotificationCommand) (value.Notification, error) {
 	data := map[string]string{
 		"taxiCallRequestId":      cmd.TaxiCallRequestId,
@@ -24,7 +25,7 @@ func (t taxiCallPushApp) handleUserTaxiCallRequestProgress(ctx context.Context,
 	}, nil
 }
 
-func (t taxiCallPushApp) handleUserTaxiCallRequestAccepted(ctx context.Context, fcmToken string,
+func (t taxiCallPushApp) handleUserTaxiCallRequestAccepted(ctx context.Context, fcmToken string, language enum.Language,
 	eventTime time.Time, cmd command.UserTaxiCallNotificationCommand) (value.Notification, error) {
 	routeBetweenDeparture, err := t.service.route.GetRoute(ctx, cmd.DriverLocation, cmd.Departure.Point)
 	if err != nil {
@@ -32,9 +33,23 @@ func (t taxiCallPushApp) handleUserTaxiCallRequestAccepted(ctx context.Context,
 			fmt.Errorf("app.push.handleUserTaxiCallRequestAccepted: error while get route between driver location and departure: %w", err)
 	}
 
-	message := value.NotificationMessage{
-		Title: fmt.Sprintf("배차 완료 (약 %d분)", int(routeBetweenDeparture.ETA.Minutes())),
-		Body:  fmt.Sprintf("%s (추가 요금 %d)", cmd.Departure.Address.AddressName, cmd.AdditionalPrice),
+	message, err := t.templates.render(templateType_UserTaxiCallAccepted, language, struct {
+		EtaMinutes         int
+		DepartureAddress   string
+		AdditionalPrice    int
+		VehiclePlateNumber string
+		VehicleModel       string
+		VehicleColor       string
+	}{
+		EtaMinutes:         int(routeBetweenDeparture.ETA.Minutes()),
+		DepartureAddress:   cmd.Departure.Address.AddressName,
+		AdditionalPrice:    cmd.AdditionalPrice,
+		VehiclePlateNumber: cmd.VehiclePlateNumber,
+		VehicleModel:       cmd.VehicleModel,
+		VehicleColor:       cmd.VehicleColor,
+	})
+	if err != nil {
+		return value.Notification{}, fmt.Errorf("app.push.handleUserTaxiCallRequestAccepted: error while render message: %w", err)
 	}
 
 	data := map[string]string{
@@ -45,6 +60,11 @@ func (t taxiCallPushApp) handleUserTaxiCallRequestAccepted(ctx context.Context,
 		"additionalPrice":       fmt.Sprint(cmd.AdditionalPrice),
 		"toDepartureDistance":   fmt.Sprint(routeBetweenDeparture.Distance),
 		"whenDriverToDeparture": fmt.Sprint(time.Now().Add(routeBetweenDeparture.ETA)),
+		"vehiclePlateNumber":    cmd.VehiclePlateNumber,
+		"vehicleModel":          cmd.VehicleModel,
+		"vehicleColor":          cmd.VehicleColor,
+		"vehicleCapacity":       fmt.Sprint(cmd.VehicleCapacity),
+		"vehicleClass":          cmd.VehicleClass,
 	}
 
 	return value.Notification{
@@ -54,12 +74,12 @@ func (t taxiCallPushApp) handleUserTaxiCallRequestAccepted(ctx context.Context,
 	}, nil
 }
 
-func (t taxiCallPushApp) handleUserTaxiCallRequestFailed(ctx context.Context, fcmToken string,
+func (t taxiCallPushApp) handleUserTaxiCallRequestFailed(ctx context.Context, fcmToken string, language enum.Language,
 	eventTime time.Time, cmd command.UserTaxiCallNotificationCommand) (value.Notification, error) {
 
-	message := value.NotificationMessage{
-		Title: "배차 실패",
-		Body:  "택시 배차에 실패했습니다",
+	message, err := t.templates.render(templateType_UserTaxiCallFailed, language, nil)
+	if err != nil {
+		return value.Notification{}, fmt.Errorf("app.push.handleUserTaxiCallRequestFailed: error while render message: %w", err)
 	}
 
 	data := map[string]string{
@@ -74,12 +94,12 @@ func (t taxiCallPushApp) handleUserTaxiCallRequestFailed(ctx context.Context, fc
 	}, nil
 }
 
-func (t taxiCallPushApp) handleUserTaxiCallRequestDone(ctx context.Context, fcmToken string,
+func (t taxiCallPushApp) handleUserTaxiCallRequestDone(ctx context.Context, fcmToken string, language enum.Language,
 	eventTime time.Time, cmd command.UserTaxiCallNotificationCommand) (value.Notification, error) {
 
-	message := value.NotificationMessage{
-		Title: "운행 완료",
-		Body:  "택시 운행이 완료되었습니다.",
+	message, err := t.templates.render(templateType_UserTaxiCallDone, language, nil)
+	if err != nil {
+		return value.Notification{}, fmt.Errorf("app.push.handleUserTaxiCallRequestDone: error while render message: %w", err)
 	}
 
 	data := map[string]string{
@@ -95,3 +115,49 @@ func (t taxiCallPushApp) handleUserTaxiCallRequestDone(ctx context.Context, fcmT
 		Data:      data,
 	}, nil
 }
+
+func (t taxiCallPushApp) handleUserTaxiCallRequestToArrival(ctx context.Context, fcmToken string, language enum.Language,
+	eventTime time.Time, cmd command.UserTaxiCallNotificationCommand) (value.Notification, error) {
+
+	message, err := t.templates.render(templateType_UserTaxiCallToArrival, language, struct {
+		ArrivalAddress string
+	}{
+		ArrivalAddress: cmd.Arrival.Address.AddressName,
+	})
+	if err != nil {
+		return value.Notification{}, fmt.Errorf("app.push.handleUserTaxiCallRequestToArrival: error while render message: %w", err)
+	}
+
+	data := map[string]string{
+		"taxiCallRequestId": cmd.TaxiCallRequestId,
+		"taxiCallState":     cmd.TaxiCallState,
+		"driverId":          cmd.DriverId,
+	}
+
+	return value.Notification{
+		Principal: fcmToken,
+		Message:   message,
+		Data:      data,
+	}, nil
+}
+
+func (t taxiCallPushApp) handleUserTaxiCallRequestDriverCancelled(ctx context.Context, fcmToken string, language enum.Language,
+	eventTime time.Time, cmd command.UserTaxiCallNotificationCommand) (value.Notification, error) {
+
+	message, err := t.templates.render(templateType_UserTaxiCallDriverCancelled, language, nil)
+	if err != nil {
+		return value.Notification{}, fmt.Errorf("app.push.handleUserTaxiCallRequestDriverCancelled: error while render message: %w", err)
+	}
+
+	data := map[string]string{
+		"taxiCallRequestId": cmd.TaxiCallRequestId,
+		"taxiCallState":     cmd.TaxiCallState,
+		"driverId":          cmd.DriverId,
+	}
+
+	return value.Notification{
+		Principal: fcmToken,
+		Message:   message,
+		Data:      data,
+	}, nil
+}
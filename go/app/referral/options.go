@@ -0,0 +1,74 @@
+package referral
+
+import (
+	"errors"
+
+	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/repository"
+)
+
+type referralAppOption func(*referralApp)
+
+func WithTransactor(transactor app.Transactor) referralAppOption {
+	return func(ra *referralApp) {
+		ra.Transactor = transactor
+	}
+}
+
+func WithReferralRepository(repo repository.ReferralRepository) referralAppOption {
+	return func(ra *referralApp) {
+		ra.repository.referral = repo
+	}
+}
+
+func WithUserRepository(repo repository.UserRepository) referralAppOption {
+	return func(ra *referralApp) {
+		ra.repository.user = repo
+	}
+}
+
+func WithUserPaymentRepository(repo repository.UserPaymentRepository) referralAppOption {
+	return func(ra *referralApp) {
+		ra.repository.payment = repo
+	}
+}
+
+func WithCouponService(svc couponServiceInterface) referralAppOption {
+	return func(ra *referralApp) {
+		ra.service.coupon = svc
+	}
+}
+
+func (r referralApp) validateApp() error {
+	if r.Transactor == nil {
+		return errors.New("referral app need transactor")
+	}
+
+	if r.repository.referral == nil {
+		return errors.New("referral app need referral repository")
+	}
+
+	if r.repository.user == nil {
+		return errors.New("referral app need user repository")
+	}
+
+	if r.repository.payment == nil {
+		return errors.New("referral app need user payment repository")
+	}
+
+	if r.service.coupon == nil {
+		return errors.New("referral app need coupon service")
+	}
+
+	return nil
+}
+
+func NewReferralApp(opts ...referralAppOption) (referralApp, error) {
+	app := referralApp{}
+
+	for _, opt := range opts {
+		opt(&app)
+	}
+
+	return app, app.validateApp()
+}
@@ -0,0 +1,228 @@
+package referral
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/request"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/taco-labs/taco/go/repository"
+	"github.com/taco-labs/taco/go/utils"
+	"github.com/taco-labs/taco/go/utils/slices"
+	"github.com/uptrace/bun"
+)
+
+// TODO (taekyeom) To be parameterized
+const (
+	rewardCouponDiscountPrice = 3000
+	rewardCouponValidDays     = 30
+	phonePrefixLength         = 7
+)
+
+const (
+	rejectReasonSamePhonePrefix = "SAME_PHONE_PREFIX"
+	rejectReasonSamePaymentCard = "SAME_PAYMENT_CARD"
+)
+
+type couponServiceInterface interface {
+	CreateCoupon(context.Context, request.CreateCouponRequest) (entity.Coupon, error)
+}
+
+type referralApp struct {
+	app.Transactor
+	repository struct {
+		referral repository.ReferralRepository
+		user     repository.UserRepository
+		payment  repository.UserPaymentRepository
+	}
+	service struct {
+		coupon couponServiceInterface
+	}
+}
+
+func (r referralApp) CreateReferral(ctx context.Context, referee entity.User, referralCode string) error {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	return r.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		referrer, err := r.repository.user.FindByReferralCode(ctx, i, referralCode)
+		if errors.Is(err, value.ErrUserNotFound) {
+			return fmt.Errorf("app.referral.CreateReferral: referrer not found: %w", value.ErrInvalidReferralCode)
+		}
+		if err != nil {
+			return fmt.Errorf("app.referral.CreateReferral: error while find referrer: %w", err)
+		}
+
+		if referrer.Id == referee.Id {
+			return fmt.Errorf("app.referral.CreateReferral: self referral: %w", value.ErrInvalidReferralCode)
+		}
+
+		referral := entity.UserReferral{
+			RefereeUserId:  referee.Id,
+			ReferrerUserId: referrer.Id,
+			State:          enum.ReferralState_PENDING,
+			CreateTime:     requestTime,
+			UpdateTime:     requestTime,
+		}
+		if err := r.repository.referral.Create(ctx, i, referral); err != nil {
+			return fmt.Errorf("app.referral.CreateReferral: error while create referral: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// RewardReferral gives reward coupons to both referrer and referee when the referee completes the first taxi call
+func (r referralApp) RewardReferral(ctx context.Context, taxiCallRequest entity.TaxiCallRequest) error {
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	return r.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		referral, err := r.repository.referral.GetByRefereeUserId(ctx, i, taxiCallRequest.UserId)
+		if errors.Is(err, value.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("app.referral.RewardReferral: error while get referral: %w", err)
+		}
+		if referral.State != enum.ReferralState_PENDING {
+			return nil
+		}
+
+		rejectReason, err := r.checkFraud(ctx, i, referral)
+		if err != nil {
+			return fmt.Errorf("app.referral.RewardReferral: error while check fraud: %w", err)
+		}
+
+		referral.UpdateTime = requestTime
+		if rejectReason != "" {
+			referral.State = enum.ReferralState_REJECTED
+			referral.RejectReason = rejectReason
+			if err := r.repository.referral.Update(ctx, i, referral); err != nil {
+				return fmt.Errorf("app.referral.RewardReferral: error while update referral: %w", err)
+			}
+			return nil
+		}
+
+		for _, userId := range []string{referral.ReferrerUserId, referral.RefereeUserId} {
+			if _, err := r.service.coupon.CreateCoupon(ctx, request.CreateCouponRequest{
+				Code:           utils.MustNewUUID(),
+				Name:           "친구 초대 보상",
+				DiscountType:   string(enum.CouponDiscountType_FIXED),
+				DiscountAmount: rewardCouponDiscountPrice,
+				UsageLimit:     1,
+				PerUserLimit:   1,
+				UserId:         userId,
+				StartTime:      requestTime,
+				ExpireTime:     requestTime.AddDate(0, 0, rewardCouponValidDays),
+			}); err != nil {
+				return fmt.Errorf("app.referral.RewardReferral: error while create reward coupon: %w", err)
+			}
+		}
+
+		referral.State = enum.ReferralState_REWARDED
+		if err := r.repository.referral.Update(ctx, i, referral); err != nil {
+			return fmt.Errorf("app.referral.RewardReferral: error while update referral: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r referralApp) GetReferralStats(ctx context.Context) (entity.ReferralStats, error) {
+	var stats entity.ReferralStats
+	var err error
+
+	err = r.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		stats, err = r.repository.referral.GetStats(ctx, i)
+		if err != nil {
+			return fmt.Errorf("app.referral.GetReferralStats: error while get referral stats: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return entity.ReferralStats{}, err
+	}
+
+	return stats, nil
+}
+
+func (r referralApp) GetUserReferralStats(ctx context.Context, userId string) (entity.ReferralStats, error) {
+	var stats entity.ReferralStats
+	var err error
+
+	err = r.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		stats, err = r.repository.referral.GetStatsByReferrerUserId(ctx, i, userId)
+		if err != nil {
+			return fmt.Errorf("app.referral.GetUserReferralStats: error while get referral stats: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return entity.ReferralStats{}, err
+	}
+
+	return stats, nil
+}
+
+func (r referralApp) checkFraud(ctx context.Context, db bun.IDB, referral entity.UserReferral) (string, error) {
+	referrer, err := r.repository.user.FindById(ctx, db, referral.ReferrerUserId)
+	if err != nil {
+		return "", fmt.Errorf("error while find referrer: %w", err)
+	}
+
+	referee, err := r.repository.user.FindById(ctx, db, referral.RefereeUserId)
+	if err != nil {
+		return "", fmt.Errorf("error while find referee: %w", err)
+	}
+
+	if samePhonePrefix(referrer.Phone, referee.Phone) {
+		return rejectReasonSamePhonePrefix, nil
+	}
+
+	referrerPayments, err := r.repository.payment.ListUserPayment(ctx, db, referrer.Id)
+	if err != nil {
+		return "", fmt.Errorf("error while list referrer payments: %w", err)
+	}
+
+	refereePayments, err := r.repository.payment.ListUserPayment(ctx, db, referee.Id)
+	if err != nil {
+		return "", fmt.Errorf("error while list referee payments: %w", err)
+	}
+
+	referrerCards := slices.Map(referrerPayments, paymentCardKey)
+	for _, refereePayment := range refereePayments {
+		if slices.Contains(referrerCards, paymentCardKey(refereePayment)) {
+			return rejectReasonSamePaymentCard, nil
+		}
+	}
+
+	return "", nil
+}
+
+func samePhonePrefix(a, b string) bool {
+	a, b = phoneDigits(a), phoneDigits(b)
+	if len(a) < phonePrefixLength || len(b) < phonePrefixLength {
+		return false
+	}
+	return a[:phonePrefixLength] == b[:phonePrefixLength]
+}
+
+func phoneDigits(phone string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, phone)
+}
+
+func paymentCardKey(payment entity.UserPayment) string {
+	return fmt.Sprintf("%s:%s", payment.CardCompany, payment.RedactedCardNumber)
+}
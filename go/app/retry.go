@@ -0,0 +1,40 @@
+package app
+
+import (
+	"context"
+	"math"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/event/command"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/service"
+)
+
+// RetryPolicyOf returns retry policy of the event. Max retry count carried by the event takes precedence,
+// so that policy change does not affect events already being retried
+func RetryPolicyOf(event entity.Event) value.RetryPolicy {
+	policy := command.RetryPolicyOf(event.EventUri)
+	if event.MaxRetryCount > 0 {
+		policy.MaxRetryCount = event.MaxRetryCount
+	}
+	return policy
+}
+
+// RetryEvent publishes the event again with backoff delay of its retry policy.
+// Returns false without publishing if retry count is exhausted
+func RetryEvent(ctx context.Context, eventPub service.EventPublishService, event entity.Event) (bool, error) {
+	policy := RetryPolicyOf(event)
+	if event.RetryCount >= policy.MaxRetryCount {
+		return false, nil
+	}
+
+	retryEvent := event.NewEventWithRetry()
+	retryEvent.MaxRetryCount = policy.MaxRetryCount
+	retryEvent.DelaySeconds = int64(math.Ceil(policy.Backoff(event.RetryCount).Seconds()))
+
+	if err := eventPub.SendMessage(ctx, retryEvent); err != nil {
+		return true, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,97 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/event/command"
+	"github.com/taco-labs/taco/go/domain/value"
+)
+
+type fakeEventPublishService struct {
+	events []entity.Event
+	err    error
+}
+
+func (f *fakeEventPublishService) SendMessage(ctx context.Context, event entity.Event) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.events = append(f.events, event)
+	return nil
+}
+
+func TestRetryPolicyOf(t *testing.T) {
+	event := entity.Event{EventUri: command.EventUri_TaxiCallProcess}
+	if policy := RetryPolicyOf(event); policy != command.RetryPolicyOf(command.EventUri_TaxiCallProcess) {
+		t.Errorf("expected policy of the event uri, got %+v", policy)
+	}
+
+	event.MaxRetryCount = 7
+	if policy := RetryPolicyOf(event); policy.MaxRetryCount != 7 {
+		t.Errorf("expected max retry count carried by the event, got %d", policy.MaxRetryCount)
+	}
+
+	if policy := RetryPolicyOf(entity.Event{EventUri: "unknown"}); policy != value.DefaultRetryPolicy {
+		t.Errorf("expected default policy, got %+v", policy)
+	}
+}
+
+func TestRetryEvent(t *testing.T) {
+	policy := command.RetryPolicyOf(command.EventUri_UserTaxiCallNotification)
+	event := entity.Event{
+		MessageId:  "message",
+		EventUri:   command.EventUri_UserTaxiCallNotification,
+		Payload:    []byte("{}"),
+		CreateTime: time.Now(),
+	}
+
+	t.Run("retry with backoff", func(t *testing.T) {
+		eventPub := &fakeEventPublishService{}
+
+		retried, err := RetryEvent(context.Background(), eventPub, event)
+		if err != nil || !retried {
+			t.Fatalf("expected event to be retried, got %v, %v", retried, err)
+		}
+		if len(eventPub.events) != 1 {
+			t.Fatalf("expected 1 published event, got %d", len(eventPub.events))
+		}
+
+		retryEvent := eventPub.events[0]
+		if retryEvent.RetryCount != 1 {
+			t.Errorf("expected retry count 1, got %d", retryEvent.RetryCount)
+		}
+		if retryEvent.MaxRetryCount != policy.MaxRetryCount {
+			t.Errorf("expected max retry count %d, got %d", policy.MaxRetryCount, retryEvent.MaxRetryCount)
+		}
+		if ceil := int64(policy.BaseDelay.Seconds()); retryEvent.DelaySeconds < 0 || retryEvent.DelaySeconds > ceil {
+			t.Errorf("expected delay within [0, %d] seconds, got %d", ceil, retryEvent.DelaySeconds)
+		}
+	})
+
+	t.Run("retry count exhausted", func(t *testing.T) {
+		eventPub := &fakeEventPublishService{}
+		exhausted := event
+		exhausted.RetryCount = policy.MaxRetryCount
+
+		retried, err := RetryEvent(context.Background(), eventPub, exhausted)
+		if err != nil || retried {
+			t.Fatalf("expected event not to be retried, got %v, %v", retried, err)
+		}
+		if len(eventPub.events) != 0 {
+			t.Errorf("expected no published event, got %d", len(eventPub.events))
+		}
+	})
+
+	t.Run("publish failure", func(t *testing.T) {
+		eventPub := &fakeEventPublishService{err: errors.New("publish failed")}
+
+		retried, err := RetryEvent(context.Background(), eventPub, event)
+		if err == nil || !retried {
+			t.Errorf("expected publish error, got %v, %v", retried, err)
+		}
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/taco-labs/taco/go/domain/request"
 	"github.com/taco-labs/taco/go/domain/value"
 	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/taco-labs/taco/go/metric"
 	"github.com/taco-labs/taco/go/utils"
 	"github.com/uptrace/bun"
 )
@@ -124,6 +125,8 @@ func (t taxicallApp) LatestDriverTaxiCallRequest(ctx context.Context, driverId s
 
 // TODO (taekyeom) Remove it later!!
 func (t taxicallApp) ForceAcceptTaxiCallRequest(ctx context.Context, driverId, callRequestId string) error {
+	ctx = utils.SetTaxiCallRequestId(ctx, callRequestId)
+
 	return t.Run(ctx, func(ctx context.Context, i bun.IDB) error {
 		ticket, err := t.repository.taxiCallRequest.GetLatestTicketByRequestId(ctx, i, callRequestId)
 		if err != nil {
@@ -142,7 +145,22 @@ func (t taxicallApp) ForceAcceptTaxiCallRequest(ctx context.Context, driverId, c
 			return fmt.Errorf("app.taxiCall.ForceAcceptTaxiCallRequest: error while upsert taxi call context: %w", value.ErrInvalidOperation)
 		}
 
-		return t.AcceptTaxiCallRequest(ctx, driverId, ticket.Id)
+		if err := t.AcceptTaxiCallRequest(ctx, driverId, ticket.Id); err != nil {
+			return err
+		}
+
+		// Driver didn't accept the ticket by oneself, so let the driver know the assignment
+		taxiCallRequest, err := t.repository.taxiCallRequest.GetById(ctx, i, callRequestId)
+		if err != nil {
+			return fmt.Errorf("app.taxiCall.ForceAcceptTaxiCallRequest: error while get taxi call request: %w", err)
+		}
+
+		driverCmd := command.NewDriverTaxiCallNotificationCommand(taxiCallRequest, ticket, driverTaxiCallContext)
+		if err := t.repository.event.BatchCreate(ctx, i, []entity.Event{driverCmd}); err != nil {
+			return fmt.Errorf("app.taxiCall.ForceAcceptTaxiCallRequest: error while create event: %w", err)
+		}
+
+		return nil
 	})
 }
 
@@ -153,7 +171,7 @@ func (t taxicallApp) AcceptTaxiCallRequest(ctx context.Context, driverId string,
 	var driverTaxiCallContext entity.DriverTaxiCallContext
 	var err error
 
-	err = t.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+	err = t.runWithHistory(ctx, func(ctx context.Context, i bun.IDB) error {
 		// TODO(taeykeom) Do we need check on duty & last call request?
 		driverTaxiCallContext, err = t.repository.taxiCallRequest.GetDriverTaxiCallContext(ctx, i, driverId)
 		if err != nil {
@@ -168,13 +186,25 @@ func (t taxicallApp) AcceptTaxiCallRequest(ctx context.Context, driverId string,
 			return fmt.Errorf("app.taxxiCall.AcceptTaxiCallRequest: error while upsert taxi call context: %w", value.ErrInvalidOperation)
 		}
 
-		// TODO(taekyeom) ticket과 현재 ticket이 다른 경우, 돈을 더 받는것도 괜찮을까?
-		ticket, err := t.repository.taxiCallRequest.GetLatestTicketByRequestId(ctx, i, driverTaxiCallContext.LastReceivedRequestTicket)
+		receivedTicket, err := t.repository.taxiCallRequest.GetTicketById(ctx, i, ticketId)
+		if errors.Is(err, value.ErrNotFound) {
+			return fmt.Errorf("app.taxxiCall.AcceptTaxiCallRequest: ticket not found:%w", value.ErrAlreadyExpiredCallRequest)
+		}
+		if err != nil {
+			return fmt.Errorf("app.taxxiCall.AcceptTaxiCallRequest: error while get received taxi call ticket:%w", err)
+		}
+		ctx = utils.SetTaxiCallRequestId(ctx, receivedTicket.TaxiCallRequestId)
+
+		ticket, err := t.repository.taxiCallRequest.GetLatestTicketByRequestId(ctx, i, receivedTicket.TaxiCallRequestId)
 		if err != nil {
 			return fmt.Errorf("app.taxxiCall.AcceptTaxiCallRequest: error while get taxi call ticket:%w", err)
 		}
+		// Received ticket is replaced with higher price one by dispatch worker
+		if receivedTicket.Id != ticket.Id {
+			return fmt.Errorf("app.taxxiCall.AcceptTaxiCallRequest: ticket is replaced:%w", value.ErrAlreadyExpiredCallRequest)
+		}
 
-		taxiCallRequest, err = t.repository.taxiCallRequest.GetById(ctx, i, driverTaxiCallContext.LastReceivedRequestTicket)
+		taxiCallRequest, err = t.repository.taxiCallRequest.GetById(ctx, i, receivedTicket.TaxiCallRequestId)
 		if err != nil {
 			return fmt.Errorf("app.taxxiCall.AcceptTaxiCallRequest: error while get taxi call request:%w", err)
 		}
@@ -182,6 +212,7 @@ func (t taxicallApp) AcceptTaxiCallRequest(ctx context.Context, driverId string,
 			return fmt.Errorf("app.taxxiCall.AcceptTaxiCallRequest: already expired taxi call request:%w", value.ErrAlreadyExpiredCallRequest)
 		}
 
+		fromState := taxiCallRequest.CurrentState
 		if err := taxiCallRequest.UpdateState(requestTime, enum.TaxiCallState_DRIVER_TO_DEPARTURE); err != nil {
 			return fmt.Errorf("app.taxxiCall.AcceptTaxiCallRequest: invalid state change:%w", err)
 		}
@@ -191,12 +222,43 @@ func (t taxicallApp) AcceptTaxiCallRequest(ctx context.Context, driverId string,
 			Valid:  true,
 			String: driverId,
 		}
-		if err := t.repository.taxiCallRequest.Update(ctx, i, taxiCallRequest); err != nil {
+		// Both of request and ticket are version checked, so that only one of concurrent acceptors
+		// (or dispatch worker advancing the ticket) can win
+		err = t.repository.taxiCallRequest.Update(ctx, i, taxiCallRequest)
+		if errors.Is(err, value.ErrVersionConflict) {
+			return fmt.Errorf("app.taxxiCall.AcceptTaxiCallRequest: taxi call request is taken:%w", value.ErrAlreadyExpiredCallRequest)
+		}
+		if err != nil {
 			return fmt.Errorf("app.taxxiCall.AcceptTaxiCallRequest: error while update taxi call request :%w", err)
 		}
 
+		ticket.UpdateTime = requestTime
+		err = t.repository.taxiCallRequest.UpsertTicket(ctx, i, ticket)
+		if errors.Is(err, value.ErrVersionConflict) {
+			return fmt.Errorf("app.taxxiCall.AcceptTaxiCallRequest: taxi call ticket is changed:%w", value.ErrAlreadyExpiredCallRequest)
+		}
+		if err != nil {
+			return fmt.Errorf("app.taxxiCall.AcceptTaxiCallRequest: error while update taxi call ticket :%w", err)
+		}
+
+		history := entity.NewTaxiCallHistory(taxiCallRequest, fromState, enum.TaxiCallActorType_DRIVER, driverId)
+		history.TicketId = ticket.Id
+		history.Location = &driverTaxiCallContext.Location
+		if err := t.createHistory(ctx, i, history); err != nil {
+			return fmt.Errorf("app.taxxiCall.AcceptTaxiCallRequest: error while create taxi call history: %w", err)
+		}
+
+		taxiCallRequest.Vehicle, err = t.getVehicle(ctx, i, driverId)
+		if err != nil {
+			return fmt.Errorf("app.taxxiCall.AcceptTaxiCallRequest: error while get vehicle: %w", err)
+		}
+
 		userCmd := command.NewUserTaxiCallNotificationCommand(taxiCallRequest, entity.TaxiCallTicket{}, entity.DriverTaxiCallContext{})
-		if err := t.repository.event.BatchCreate(ctx, i, []entity.Event{userCmd}); err != nil {
+		expiredCmds, err := t.ticketExpiredNotifications(ctx, i, taxiCallRequest, ticket, driverId)
+		if err != nil {
+			return fmt.Errorf("app.taxxiCall.AcceptTaxiCallRequest: error while get drivers of expired ticket: %w", err)
+		}
+		if err := t.repository.event.BatchCreate(ctx, i, append(expiredCmds, userCmd)); err != nil {
 			return fmt.Errorf("app.taxxiCall.AcceptTaxiCallRequest: error while create event: %w", err)
 		}
 
@@ -218,6 +280,8 @@ func (t taxicallApp) AcceptTaxiCallRequest(ctx context.Context, driverId string,
 		return err
 	}
 
+	metric.TimeToAccept.Observe(requestTime.Sub(taxiCallRequest.CreateTime).Seconds())
+
 	return nil
 }
 
@@ -242,9 +306,11 @@ func (t taxicallApp) RejectTaxiCallRequest(ctx context.Context, driverId string,
 }
 
 func (d taxicallApp) DriverToArrival(ctx context.Context, driverId string, callRequestId string) error {
+	ctx = utils.SetTaxiCallRequestId(ctx, callRequestId)
+
 	requestTime := utils.GetRequestTimeOrNow(ctx)
 
-	return d.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+	return d.runWithHistory(ctx, func(ctx context.Context, i bun.IDB) error {
 		taxiCallRequest, err := d.repository.taxiCallRequest.GetById(ctx, i, callRequestId)
 		if err != nil {
 			return fmt.Errorf("app.taxxiCall.DriverToArrival: error while get taxi call request: %w", err)
@@ -254,6 +320,7 @@ func (d taxicallApp) DriverToArrival(ctx context.Context, driverId string, callR
 			return fmt.Errorf("app.taxiCall.DriverToArrival: unauthorized access: %w", value.ErrUnAuthorized)
 		}
 
+		fromState := taxiCallRequest.CurrentState
 		if err := taxiCallRequest.UpdateState(requestTime, enum.TaxiCallState_DRIVER_TO_ARRIVAL); err != nil {
 			return fmt.Errorf("app.taxxiCall.DriverToArrival: invalid state change: %w", err)
 		}
@@ -262,19 +329,90 @@ func (d taxicallApp) DriverToArrival(ctx context.Context, driverId string, callR
 			return fmt.Errorf("app.taxxiCall.DriverToArrival: error while update taxi call request: %w", err)
 		}
 
-		// TODO (taekyeom) send push?
+		history := entity.NewTaxiCallHistory(taxiCallRequest, fromState, enum.TaxiCallActorType_DRIVER, driverId)
+		history.Location, err = d.getDriverLocation(ctx, i, driverId)
+		if err != nil {
+			return fmt.Errorf("app.taxxiCall.DriverToArrival: error while get driver location: %w", err)
+		}
+		if err := d.createHistory(ctx, i, history); err != nil {
+			return fmt.Errorf("app.taxxiCall.DriverToArrival: error while create taxi call history: %w", err)
+		}
+
+		userCmd := command.NewUserTaxiCallNotificationCommand(taxiCallRequest, entity.TaxiCallTicket{}, entity.DriverTaxiCallContext{})
+		if err := d.repository.event.BatchCreate(ctx, i, []entity.Event{userCmd}); err != nil {
+			return fmt.Errorf("app.taxxiCall.DriverToArrival: error while create event: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (t taxicallApp) CancelDriverTaxiCallRequest(ctx context.Context, driverId string, callRequestId string) error {
+	ctx = utils.SetTaxiCallRequestId(ctx, callRequestId)
+
+	requestTime := utils.GetRequestTimeOrNow(ctx)
+
+	return t.runWithHistory(ctx, func(ctx context.Context, i bun.IDB) error {
+		taxiCallRequest, err := t.repository.taxiCallRequest.GetById(ctx, i, callRequestId)
+		if err != nil {
+			return fmt.Errorf("app.taxxiCall.CancelDriverTaxiCallRequest: error while get taxi call request: %w", err)
+		}
+
+		if taxiCallRequest.DriverId.String != driverId {
+			return fmt.Errorf("app.taxiCall.CancelDriverTaxiCallRequest: unauthorized access: %w", value.ErrUnAuthorized)
+		}
+
+		fromState := taxiCallRequest.CurrentState
+		if err := taxiCallRequest.UpdateState(requestTime, enum.TaxiCallState_DRIVER_CANCELLED); err != nil {
+			return fmt.Errorf("app.taxxiCall.CancelDriverTaxiCallRequest: invalid state change: %w", err)
+		}
+
+		if err := t.repository.taxiCallRequest.Update(ctx, i, taxiCallRequest); err != nil {
+			return fmt.Errorf("app.taxxiCall.CancelDriverTaxiCallRequest: error while update taxi call request: %w", err)
+		}
+
+		history := entity.NewTaxiCallHistory(taxiCallRequest, fromState, enum.TaxiCallActorType_DRIVER, driverId)
+		history.Location, err = t.getDriverLocation(ctx, i, driverId)
+		if err != nil {
+			return fmt.Errorf("app.taxxiCall.CancelDriverTaxiCallRequest: error while get driver location: %w", err)
+		}
+		if err := t.createHistory(ctx, i, history); err != nil {
+			return fmt.Errorf("app.taxxiCall.CancelDriverTaxiCallRequest: error while create taxi call history: %w", err)
+		}
+
+		if _, err := t.releaseDriver(ctx, i, driverId); err != nil {
+			return fmt.Errorf("app.taxxiCall.CancelDriverTaxiCallRequest: error while release driver: %w", err)
+		}
+
+		if err := t.service.coupon.ReleaseCoupon(ctx, taxiCallRequest.Id); err != nil {
+			return fmt.Errorf("app.taxxiCall.CancelDriverTaxiCallRequest: error while release coupon: %w", err)
+		}
+
+		// User will be notified on termination of the taxi call request
+		processMessage := command.TaxiCallProcessMessage{
+			TaxiCallRequestId:   taxiCallRequest.Id,
+			TaxiCallState:       string(taxiCallRequest.CurrentState),
+			EventTime:           taxiCallRequest.UpdateTime,
+			DesiredScheduleTime: taxiCallRequest.UpdateTime,
+		}
+
+		if err := t.repository.event.BatchCreate(ctx, i, []entity.Event{processMessage.ToEvent()}); err != nil {
+			return fmt.Errorf("app.taxiCall.CancelDriverTaxiCallRequest: error while create taxi call process event: %w", err)
+		}
 
 		return nil
 	})
 }
 
 func (t taxicallApp) DoneTaxiCallRequest(ctx context.Context, driverId string, req request.DoneTaxiCallRequest) error {
+	ctx = utils.SetTaxiCallRequestId(ctx, req.TaxiCallRequestId)
+
 	requestTime := utils.GetRequestTimeOrNow(ctx)
 
 	var taxiCallRequest entity.TaxiCallRequest
 	var err error
 
-	err = t.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+	err = t.runWithHistory(ctx, func(ctx context.Context, i bun.IDB) error {
 		taxiCallRequest, err = t.repository.taxiCallRequest.GetById(ctx, i, req.TaxiCallRequestId)
 		if err != nil {
 			return fmt.Errorf("app.taxxiCall.DoneTaxiCallRequest: error while get taxi call request: %w", err)
@@ -289,6 +427,7 @@ func (t taxicallApp) DoneTaxiCallRequest(ctx context.Context, driverId string, r
 			return fmt.Errorf("app.taxxiCall.DoneTaxiCallRequest: forbidden access: %w", value.ErrUnAuthorized)
 		}
 
+		fromState := taxiCallRequest.CurrentState
 		if err := taxiCallRequest.UpdateState(requestTime, enum.TaxiCallState_DONE); err != nil {
 			return fmt.Errorf("app.taxxiCall.DoneTaxiCallRequest: invalid state change:%w", err)
 		}
@@ -296,10 +435,25 @@ func (t taxicallApp) DoneTaxiCallRequest(ctx context.Context, driverId string, r
 		taxiCallRequest.BasePrice = req.BasePrice
 		taxiCallRequest.UpdateTime = requestTime
 
+		couponDiscountPrice, err := t.service.coupon.UseCoupon(ctx, taxiCallRequest)
+		if err != nil {
+			return fmt.Errorf("app.taxxiCall.DoneTaxiCallRequest: error while use coupon: %w", err)
+		}
+		taxiCallRequest.CouponDiscountPrice = couponDiscountPrice
+
 		if err := t.repository.taxiCallRequest.Update(ctx, i, taxiCallRequest); err != nil {
 			return fmt.Errorf("app.taxxiCall.DoneTaxiCallRequest: error while update taxi call request :%w", err)
 		}
 
+		history := entity.NewTaxiCallHistory(taxiCallRequest, fromState, enum.TaxiCallActorType_DRIVER, driverId)
+		history.Location, err = t.getDriverLocation(ctx, i, driverId)
+		if err != nil {
+			return fmt.Errorf("app.taxxiCall.DoneTaxiCallRequest: error while get driver location: %w", err)
+		}
+		if err := t.createHistory(ctx, i, history); err != nil {
+			return fmt.Errorf("app.taxxiCall.DoneTaxiCallRequest: error while create taxi call history: %w", err)
+		}
+
 		driverTaxiCallContext, err := t.repository.taxiCallRequest.GetDriverTaxiCallContext(ctx, i, driverId)
 		if err != nil {
 			return fmt.Errorf("app.taxxiCall.RejectTaxiCallRequest: error while get taxi call context:%w", err)
@@ -318,6 +472,10 @@ func (t taxicallApp) DoneTaxiCallRequest(ctx context.Context, driverId string, r
 			return fmt.Errorf("app.taxxiCall.DoneTaxiCallRequest: error while create taxi call settlement: %w", err)
 		}
 
+		if err := t.service.referral.RewardReferral(ctx, taxiCallRequest); err != nil {
+			return fmt.Errorf("app.taxxiCall.DoneTaxiCallRequest: error while reward referral: %w", err)
+		}
+
 		processMessage := command.TaxiCallProcessMessage{
 			TaxiCallRequestId:   taxiCallRequest.Id,
 			TaxiCallState:       string(taxiCallRequest.CurrentState),
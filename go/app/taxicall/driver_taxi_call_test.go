@@ -0,0 +1,74 @@
+package taxicall
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/uptrace/bun"
+)
+
+// fakeAcceptTaxiCallRepository serves driver taxi call context & received ticket in addition to the process fake
+type fakeAcceptTaxiCallRepository struct {
+	*fakeTaxiCallRepository
+
+	driverTaxiCallContext entity.DriverTaxiCallContext
+	tickets               map[string]entity.TaxiCallTicket
+}
+
+func (f *fakeAcceptTaxiCallRepository) GetDriverTaxiCallContext(ctx context.Context, db bun.IDB, driverId string) (entity.DriverTaxiCallContext, error) {
+	return f.driverTaxiCallContext, nil
+}
+
+func (f *fakeAcceptTaxiCallRepository) UpsertDriverTaxiCallContext(ctx context.Context, db bun.IDB, driverTaxiCallContext entity.DriverTaxiCallContext) error {
+	f.calls = append(f.calls, "UpsertDriverTaxiCallContext")
+	return nil
+}
+
+func (f *fakeAcceptTaxiCallRepository) GetTicketById(ctx context.Context, db bun.IDB, ticketId string) (entity.TaxiCallTicket, error) {
+	ticket, ok := f.tickets[ticketId]
+	if !ok {
+		return entity.TaxiCallTicket{}, value.ErrNotFound
+	}
+	return ticket, nil
+}
+
+func TestAcceptTaxiCallRequest_ReplacedTicket(t *testing.T) {
+	f := newProcessFixture(enum.TaxiCallState_Requested)
+
+	previousTicket := entity.TaxiCallTicket{Id: "previous", TaxiCallRequestId: "request", Attempt: 3}
+	latestTicket := entity.TaxiCallTicket{Id: "latest", TaxiCallRequestId: "request", Attempt: 1, AdditionalPrice: entity.PriceStep}
+	f.request.ticket = &latestTicket
+
+	repo := &fakeAcceptTaxiCallRepository{
+		fakeTaxiCallRepository: f.request,
+		driverTaxiCallContext: entity.DriverTaxiCallContext{
+			DriverId:                  "driver",
+			CanReceive:                true,
+			LastReceivedRequestTicket: previousTicket.Id,
+		},
+		tickets: map[string]entity.TaxiCallTicket{
+			previousTicket.Id: previousTicket,
+			latestTicket.Id:   latestTicket,
+		},
+	}
+	f.app.repository.taxiCallRequest = repo
+
+	err := f.app.AcceptTaxiCallRequest(context.Background(), "driver", previousTicket.Id)
+	if !errors.Is(err, value.ErrAlreadyExpiredCallRequest) {
+		t.Fatalf("expected already expired error, got %v", err)
+	}
+
+	for _, call := range f.request.calls {
+		if call == "Update" || call == "UpsertTicket" {
+			t.Errorf("expected neither request nor ticket to be updated, got %v", f.request.calls)
+			break
+		}
+	}
+	if len(f.history.histories) != 0 || len(f.event.batches) != 0 {
+		t.Errorf("expected no history & event, got %d histories, %d batches", len(f.history.histories), len(f.event.batches))
+	}
+}
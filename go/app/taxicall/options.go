@@ -34,6 +34,18 @@ func WithEventRepository(repo repository.EventRepository) taxicallAppOption {
 	}
 }
 
+func WithVehicleRepository(repo repository.VehicleRepository) taxicallAppOption {
+	return func(ta *taxicallApp) {
+		ta.repository.vehicle = repo
+	}
+}
+
+func WithTaxiCallHistoryRepository(repo repository.TaxiCallHistoryRepository) taxicallAppOption {
+	return func(ta *taxicallApp) {
+		ta.repository.history = repo
+	}
+}
+
 func WithRouteServie(svc service.MapRouteService) taxicallAppOption {
 	return func(ta *taxicallApp) {
 		ta.service.route = svc
@@ -58,6 +70,30 @@ func WithEventSubscriberService(svc service.EventSubscriptionService) taxicallAp
 	}
 }
 
+func WithCouponService(svc couponServiceInterface) taxicallAppOption {
+	return func(ta *taxicallApp) {
+		ta.service.coupon = svc
+	}
+}
+
+func WithReferralService(svc referralServiceInterface) taxicallAppOption {
+	return func(ta *taxicallApp) {
+		ta.service.referral = svc
+	}
+}
+
+func WithCorporateService(svc corporateServiceInterface) taxicallAppOption {
+	return func(ta *taxicallApp) {
+		ta.service.corporate = svc
+	}
+}
+
+func WithDeadLetterService(svc deadLetterServiceInterface) taxicallAppOption {
+	return func(ta *taxicallApp) {
+		ta.service.deadLetter = svc
+	}
+}
+
 func (t taxicallApp) validateApp() error {
 	if t.Transactor == nil {
 		return errors.New("taxi call app needs transactor ")
@@ -75,6 +111,14 @@ func (t taxicallApp) validateApp() error {
 		return errors.New("taxi call app needs event repository")
 	}
 
+	if t.repository.vehicle == nil {
+		return errors.New("taxi call app needs vehicle repository")
+	}
+
+	if t.repository.history == nil {
+		return errors.New("taxi call app needs taxi call history repository")
+	}
+
 	if t.service.route == nil {
 		return errors.New("taxi call app needs route service")
 	}
@@ -91,12 +135,29 @@ func (t taxicallApp) validateApp() error {
 		return errors.New("taxi call app needs event sub service")
 	}
 
+	if t.service.coupon == nil {
+		return errors.New("taxi call app needs coupon service")
+	}
+
+	if t.service.referral == nil {
+		return errors.New("taxi call app needs referral service")
+	}
+
+	if t.service.corporate == nil {
+		return errors.New("taxi call app needs corporate service")
+	}
+
+	if t.service.deadLetter == nil {
+		return errors.New("taxi call app needs dead letter service")
+	}
+
 	return nil
 }
 
 func NewTaxicallApp(opts ...taxicallAppOption) (taxicallApp, error) {
 	app := taxicallApp{
-		waitCh: make(chan struct{}),
+		stopCh: make(chan struct{}),
+		waitCh: make(chan struct{}, 1),
 	}
 
 	for _, opt := range opts {
@@ -0,0 +1,145 @@
+package taxicall
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/metric"
+	"github.com/taco-labs/taco/go/utils"
+	"github.com/uptrace/bun"
+)
+
+func (t taxicallApp) ListTaxiCallHistory(ctx context.Context, taxiCallRequestId string) ([]entity.TaxiCallHistory, error) {
+	ctx = utils.SetTaxiCallRequestId(ctx, taxiCallRequestId)
+
+	var histories []entity.TaxiCallHistory
+
+	err := t.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		if _, err := t.repository.taxiCallRequest.GetById(ctx, i, taxiCallRequestId); err != nil {
+			return fmt.Errorf("app.taxiCall.ListTaxiCallHistory: error while get taxi call request: %w", err)
+		}
+
+		resp, err := t.repository.history.ListByRequestId(ctx, i, taxiCallRequestId)
+		if err != nil {
+			return fmt.Errorf("app.taxiCall.ListTaxiCallHistory: error while list taxi call histories: %w", err)
+		}
+		histories = resp
+
+		return nil
+	})
+
+	if err != nil {
+		return []entity.TaxiCallHistory{}, err
+	}
+
+	return histories, nil
+}
+
+func (t taxicallApp) ListUserTaxiCallHistory(ctx context.Context, userId string, taxiCallRequestId string) ([]entity.TaxiCallHistory, error) {
+	ctx = utils.SetTaxiCallRequestId(ctx, taxiCallRequestId)
+
+	var histories []entity.TaxiCallHistory
+
+	err := t.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		taxiCallRequest, err := t.repository.taxiCallRequest.GetById(ctx, i, taxiCallRequestId)
+		if err != nil {
+			return fmt.Errorf("app.taxiCall.ListUserTaxiCallHistory: error while get taxi call request: %w", err)
+		}
+
+		if taxiCallRequest.UserId != userId {
+			return fmt.Errorf("app.taxiCall.ListUserTaxiCallHistory: unauthorized access: %w", value.ErrUnAuthorized)
+		}
+
+		resp, err := t.repository.history.ListByRequestId(ctx, i, taxiCallRequestId)
+		if err != nil {
+			return fmt.Errorf("app.taxiCall.ListUserTaxiCallHistory: error while list taxi call histories: %w", err)
+		}
+		histories = resp
+
+		return nil
+	})
+
+	if err != nil {
+		return []entity.TaxiCallHistory{}, err
+	}
+
+	return histories, nil
+}
+
+func (t taxicallApp) ListDriverTaxiCallHistory(ctx context.Context, driverId string, taxiCallRequestId string) ([]entity.TaxiCallHistory, error) {
+	ctx = utils.SetTaxiCallRequestId(ctx, taxiCallRequestId)
+
+	var histories []entity.TaxiCallHistory
+
+	err := t.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+		taxiCallRequest, err := t.repository.taxiCallRequest.GetById(ctx, i, taxiCallRequestId)
+		if err != nil {
+			return fmt.Errorf("app.taxiCall.ListDriverTaxiCallHistory: error while get taxi call request: %w", err)
+		}
+
+		if taxiCallRequest.DriverId.String != driverId {
+			return fmt.Errorf("app.taxiCall.ListDriverTaxiCallHistory: unauthorized access: %w", value.ErrUnAuthorized)
+		}
+
+		resp, err := t.repository.history.ListByRequestId(ctx, i, taxiCallRequestId)
+		if err != nil {
+			return fmt.Errorf("app.taxiCall.ListDriverTaxiCallHistory: error while list taxi call histories: %w", err)
+		}
+		histories = resp
+
+		return nil
+	})
+
+	if err != nil {
+		return []entity.TaxiCallHistory{}, err
+	}
+
+	return histories, nil
+}
+
+type historiesKey struct{}
+
+// runWithHistory runs fn in transaction and counts state transitions of histories created by createHistory
+// after the transaction is committed, so that rolled back transition (e.g. version conflict) is not counted
+func (t taxicallApp) runWithHistory(ctx context.Context, fn func(context.Context, bun.IDB) error) error {
+	histories := &[]entity.TaxiCallHistory{}
+	ctx = context.WithValue(ctx, historiesKey{}, histories)
+
+	if err := t.Run(ctx, fn); err != nil {
+		return err
+	}
+
+	for _, history := range *histories {
+		countStateTransition(history)
+	}
+
+	return nil
+}
+
+// createHistory creates history and keeps it to be counted by runWithHistory
+func (t taxicallApp) createHistory(ctx context.Context, db bun.IDB, history entity.TaxiCallHistory) error {
+	if err := t.repository.history.Create(ctx, db, history); err != nil {
+		return err
+	}
+
+	if histories, ok := ctx.Value(historiesKey{}).(*[]entity.TaxiCallHistory); ok {
+		*histories = append(*histories, history)
+	}
+
+	return nil
+}
+
+// countStateTransition counts the state transition. Retried ticket of the same state is not counted as transition
+func countStateTransition(history entity.TaxiCallHistory) {
+	if history.FromState == history.ToState {
+		return
+	}
+
+	fromState := string(history.FromState)
+	if fromState == "" {
+		fromState = "NONE"
+	}
+	metric.StateTransitions.WithLabelValues(fromState, string(history.ToState)).Inc()
+}
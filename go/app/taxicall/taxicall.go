@@ -1,23 +1,124 @@
 package taxicall
 
 import (
+	"context"
+	"errors"
+
 	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/event/command"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
 	"github.com/taco-labs/taco/go/repository"
 	"github.com/taco-labs/taco/go/service"
+	"github.com/taco-labs/taco/go/utils/slices"
+	"github.com/uptrace/bun"
 )
 
+type couponServiceInterface interface {
+	GetDiscountPrice(context.Context, string, entity.TaxiCallRequest) (int, error)
+	ReserveCoupon(context.Context, string, entity.TaxiCallRequest) (int, error)
+	UseCoupon(context.Context, entity.TaxiCallRequest) (int, error)
+	ReleaseCoupon(context.Context, string) error
+}
+
+type referralServiceInterface interface {
+	RewardReferral(context.Context, entity.TaxiCallRequest) error
+}
+
+type corporateServiceInterface interface {
+	ApplyPolicy(context.Context, string, entity.TaxiCallRequest) (entity.TaxiCallRequest, error)
+}
+
+type deadLetterServiceInterface interface {
+	CreateDeadLetter(context.Context, enum.EventTopic, entity.Event, error) error
+}
+
 type taxicallApp struct {
 	app.Transactor
 	repository struct {
 		driverLocation  repository.DriverLocationRepository
 		taxiCallRequest repository.TaxiCallRepository
 		event           repository.EventRepository
+		vehicle         repository.VehicleRepository
+		history         repository.TaxiCallHistoryRepository
 	}
 	service struct {
-		route    service.MapRouteService
-		location service.LocationService
-		eventPub service.EventPublishService
-		eventSub service.EventSubscriptionService
+		route      service.MapRouteService
+		location   service.LocationService
+		eventPub   service.EventPublishService
+		eventSub   service.EventSubscriptionService
+		coupon     couponServiceInterface
+		referral   referralServiceInterface
+		corporate  corporateServiceInterface
+		deadLetter deadLetterServiceInterface
 	}
+	stopCh chan struct{}
 	waitCh chan struct{}
 }
+
+// getDriverLocation returns nil if the driver has not reported location yet
+func (t taxicallApp) getDriverLocation(ctx context.Context, db bun.IDB, driverId string) (*value.Point, error) {
+	driverLocation, err := t.repository.driverLocation.GetByDriverId(ctx, db, driverId)
+	if errors.Is(err, value.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &driverLocation.Location, nil
+}
+
+// getVehicle returns empty vehicle if the driver has not registered vehicle yet
+func (t taxicallApp) getVehicle(ctx context.Context, db bun.IDB, driverId string) (entity.Vehicle, error) {
+	vehicle, err := t.repository.vehicle.GetByDriverId(ctx, db, driverId)
+	if errors.Is(err, value.ErrNotFound) {
+		return entity.Vehicle{}, nil
+	}
+	if err != nil {
+		return entity.Vehicle{}, err
+	}
+
+	return vehicle, nil
+}
+
+// ticketExpiredNotifications builds notifications for drivers who received the ticket but neither accepted nor rejected it.
+// Drivers in excludeDriverIds (eg. receivers of the next ticket) are not notified.
+func (t taxicallApp) ticketExpiredNotifications(ctx context.Context, db bun.IDB, taxiCallRequest entity.TaxiCallRequest,
+	ticket entity.TaxiCallTicket, excludeDriverIds ...string) ([]entity.Event, error) {
+	driverTaxiCallContexts, err := t.repository.taxiCallRequest.ListUnansweredDriverTaxiCallContextByTicketId(ctx, db, ticket.Id)
+	if err != nil {
+		return []entity.Event{}, err
+	}
+
+	excludes := make(map[string]struct{}, len(excludeDriverIds))
+	for _, driverId := range excludeDriverIds {
+		excludes[driverId] = struct{}{}
+	}
+
+	targets := slices.Filter(driverTaxiCallContexts, func(dctx entity.DriverTaxiCallContext) bool {
+		_, ok := excludes[dctx.DriverId]
+		return !ok
+	})
+	if len(targets) == 0 {
+		return []entity.Event{}, nil
+	}
+
+	return []entity.Event{command.NewDriverTaxiCallBulkTicketExpiredCommand(taxiCallRequest, ticket, targets)}, nil
+}
+
+// releaseDriver makes the driver assigned to cancelled taxi call request receive new tickets again
+func (t taxicallApp) releaseDriver(ctx context.Context, db bun.IDB, driverId string) (entity.DriverTaxiCallContext, error) {
+	driverTaxiCallContext, err := t.repository.taxiCallRequest.GetDriverTaxiCallContext(ctx, db, driverId)
+	if err != nil {
+		return entity.DriverTaxiCallContext{}, err
+	}
+
+	driverTaxiCallContext.CanReceive = true
+	if err := t.repository.taxiCallRequest.UpsertDriverTaxiCallContext(ctx, db, driverTaxiCallContext); err != nil {
+		return entity.DriverTaxiCallContext{}, err
+	}
+
+	return driverTaxiCallContext, nil
+}
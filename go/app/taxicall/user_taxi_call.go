@@ -45,6 +45,13 @@ func (t taxicallApp) LatestUserTaxiCallRequest(ctx context.Context, userId strin
 		if err != nil {
 			return fmt.Errorf("app.taxCall.GetLatestTaxiCall: error while get latest taxi call:\n%w", err)
 		}
+
+		if latestTaxiCallRequest.DriverId.Valid {
+			latestTaxiCallRequest.Vehicle, err = t.getVehicle(ctx, i, latestTaxiCallRequest.DriverId.String)
+			if err != nil {
+				return fmt.Errorf("app.taxCall.GetLatestTaxiCall: error while get vehicle:\n%w", err)
+			}
+		}
 		return nil
 	})
 
@@ -136,13 +143,29 @@ func (t taxicallApp) CreateTaxiCallRequest(ctx context.Context, userId string, u
 			CreateTime:                requestTime,
 			UpdateTime:                requestTime,
 		}
+		ctx = utils.SetTaxiCallRequestId(ctx, taxiCallRequest.Id)
+
+		if req.CorporateId != "" {
+			taxiCallRequest, err = t.service.corporate.ApplyPolicy(ctx, req.CorporateId, taxiCallRequest)
+			if err != nil {
+				return entity.TaxiCallRequest{}, fmt.Errorf("app.taxCall.CreateTaxiCallRequest: error while apply corporate policy:\n%w", err)
+			}
+		}
+
+		if req.CouponCode != "" {
+			discountPrice, err := t.service.coupon.GetDiscountPrice(ctx, req.CouponCode, taxiCallRequest)
+			if err != nil {
+				return entity.TaxiCallRequest{}, fmt.Errorf("app.taxCall.CreateTaxiCallRequest: error while get coupon discount price:\n%w", err)
+			}
+			taxiCallRequest.CouponDiscountPrice = discountPrice
+		}
 
 		return taxiCallRequest, nil
 	}
 
 	var taxiCallRequest entity.TaxiCallRequest
 
-	err = t.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+	err = t.runWithHistory(ctx, func(ctx context.Context, i bun.IDB) error {
 		// create taxi call request
 		taxiCallRequest = entity.TaxiCallRequest{
 			Dryrun: req.Dryrun,
@@ -170,10 +193,35 @@ func (t taxicallApp) CreateTaxiCallRequest(ctx context.Context, userId string, u
 			UpdateTime:                requestTime,
 		}
 
+		if req.CorporateId != "" {
+			taxiCallRequest, err = t.service.corporate.ApplyPolicy(ctx, req.CorporateId, taxiCallRequest)
+			if err != nil {
+				return fmt.Errorf("app.taxCall.CreateTaxiCallRequest: error while apply corporate policy:%w", err)
+			}
+		}
+
 		if err = t.repository.taxiCallRequest.Create(ctx, i, taxiCallRequest); err != nil {
 			return fmt.Errorf("app.taxCall.CreateTaxiCallRequest: error while create taxi call request:%w", err)
 		}
 
+		if req.CouponCode != "" {
+			discountPrice, err := t.service.coupon.ReserveCoupon(ctx, req.CouponCode, taxiCallRequest)
+			if err != nil {
+				return fmt.Errorf("app.taxCall.CreateTaxiCallRequest: error while reserve coupon:%w", err)
+			}
+			taxiCallRequest.CouponDiscountPrice = discountPrice
+
+			if err = t.repository.taxiCallRequest.Update(ctx, i, taxiCallRequest); err != nil {
+				return fmt.Errorf("app.taxCall.CreateTaxiCallRequest: error while update coupon discount price:%w", err)
+			}
+		}
+
+		history := entity.NewTaxiCallHistory(taxiCallRequest, "", enum.TaxiCallActorType_USER, userId)
+		history.Location = &taxiCallRequest.Departure.Point
+		if err := t.createHistory(ctx, i, history); err != nil {
+			return fmt.Errorf("app.taxiCall.CreateTaxiCallRequest: error while create taxi call history: %w", err)
+		}
+
 		processMessage := command.TaxiCallProcessMessage{
 			TaxiCallRequestId:   taxiCallRequest.Id,
 			TaxiCallState:       string(taxiCallRequest.CurrentState),
@@ -196,9 +244,11 @@ func (t taxicallApp) CreateTaxiCallRequest(ctx context.Context, userId string, u
 }
 
 func (t taxicallApp) CancelTaxiCallRequest(ctx context.Context, userId string, taxiCallId string) error {
+	ctx = utils.SetTaxiCallRequestId(ctx, taxiCallId)
+
 	requestTime := utils.GetRequestTimeOrNow(ctx)
 
-	return t.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+	return t.runWithHistory(ctx, func(ctx context.Context, i bun.IDB) error {
 		taxiCall, err := t.repository.taxiCallRequest.GetById(ctx, i, taxiCallId)
 		if err != nil {
 			return fmt.Errorf("app.taxCall.CancelTaxiCall: error while get taxi call:%w", err)
@@ -208,6 +258,7 @@ func (t taxicallApp) CancelTaxiCallRequest(ctx context.Context, userId string, t
 			return fmt.Errorf("app.taxCall.CancelTaxiCall: Invalid request:%w", value.ErrUnAuthorized)
 		}
 
+		fromState := taxiCall.CurrentState
 		if err = taxiCall.UpdateState(requestTime, enum.TaxiCallState_USER_CANCELLED); err != nil {
 			return fmt.Errorf("app.taxCall.CancelTaxiCall: error while cancel taxi call:%w", err)
 		}
@@ -216,6 +267,38 @@ func (t taxicallApp) CancelTaxiCallRequest(ctx context.Context, userId string, t
 			return fmt.Errorf("app.taxCall.CancelTaxiCall: error while update taxi call:%w", err)
 		}
 
+		history := entity.NewTaxiCallHistory(taxiCall, fromState, enum.TaxiCallActorType_USER, userId)
+		if err = t.createHistory(ctx, i, history); err != nil {
+			return fmt.Errorf("app.taxCall.CancelTaxiCall: error while create taxi call history:%w", err)
+		}
+
+		if err = t.service.coupon.ReleaseCoupon(ctx, taxiCall.Id); err != nil {
+			return fmt.Errorf("app.taxCall.CancelTaxiCall: error while release coupon:%w", err)
+		}
+
+		events := []entity.Event{}
+
+		// Let the assigned driver know & receive new tickets
+		if taxiCall.DriverId.Valid {
+			driverTaxiCallContext, err := t.releaseDriver(ctx, i, taxiCall.DriverId.String)
+			if err != nil {
+				return fmt.Errorf("app.taxCall.CancelTaxiCall: error while release driver:%w", err)
+			}
+			events = append(events, command.NewDriverTaxiCallNotificationCommand(taxiCall, entity.TaxiCallTicket{}, driverTaxiCallContext))
+		}
+
+		processMessage := command.TaxiCallProcessMessage{
+			TaxiCallRequestId:   taxiCall.Id,
+			TaxiCallState:       string(taxiCall.CurrentState),
+			EventTime:           taxiCall.UpdateTime,
+			DesiredScheduleTime: taxiCall.UpdateTime,
+		}
+		events = append(events, processMessage.ToEvent())
+
+		if err = t.repository.event.BatchCreate(ctx, i, events); err != nil {
+			return fmt.Errorf("app.taxCall.CancelTaxiCall: error while create events:%w", err)
+		}
+
 		return nil
 	})
 }
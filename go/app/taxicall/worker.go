@@ -3,6 +3,13 @@ package taxicall
 import (
 	"context"
 	"fmt"
+
+	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/taco-labs/taco/go/tracing"
+	"github.com/taco-labs/taco/go/utils"
+	"go.uber.org/zap"
 )
 
 func (t taxicallApp) Start(ctx context.Context) error {
@@ -10,41 +17,76 @@ func (t taxicallApp) Start(ctx context.Context) error {
 	return nil
 }
 
-func (t taxicallApp) Shutdown(ctx context.Context) error {
-	<-t.waitCh
-	return nil
+// Stop stops receiving new events and waits for in-flight event to be handled
+func (t taxicallApp) Stop(ctx context.Context) error {
+	close(t.stopCh)
+	select {
+	case <-t.waitCh:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("app.taxicall.Stop: error while drain in-flight event: %w", ctx.Err())
+	}
 }
 
 func (t taxicallApp) loop(ctx context.Context) {
+	receiveCtx, cancel := app.WithStop(ctx, t.stopCh)
+	defer cancel()
+
 	for {
 		select {
-		case <-ctx.Done():
-			fmt.Println("shutting down [Taxi Call Consumer] stream...")
+		case <-receiveCtx.Done():
+			utils.GetLogger(ctx).Info("shutting down taxi call consumer")
 			t.waitCh <- struct{}{}
+			return
 		default:
-			err := t.consume(ctx)
-			if err != nil {
-				//TODO (taekyeom) logging
-				fmt.Printf("[TaxiCallApp.Worker] error while consume event: %+v", err)
-			}
+			t.consume(ctx, receiveCtx)
 		}
 	}
 }
 
-func (t taxicallApp) consume(ctx context.Context) error {
-	event, err := t.service.eventSub.GetMessage(ctx)
+// consume handles an event with logger & span of the event, failed event is retried or kept as dead letter
+func (t taxicallApp) consume(ctx context.Context, receiveCtx context.Context) {
+	event, err := t.service.eventSub.GetMessage(receiveCtx)
 	if err != nil {
-		return nil
+		return
 	}
-	defer event.Ack()
 
-	err = t.handleEvent(ctx, event)
-	if err != nil && event.RetryCount < 3 {
-		newEvent := event.NewEventWithRetry()
-		newEvent.DelaySeconds = 0
-		t.service.eventPub.SendMessage(ctx, newEvent)
-		return err
+	ctx = utils.SetEvent(ctx, event.MessageId, event.EventUri)
+	ctx, span := tracing.StartConsumer(ctx, fmt.Sprintf("taxicall.consume %s", event.EventUri), event)
+
+	settled, err := t.handleEventWithRetry(ctx, event)
+	tracing.End(span, err)
+	if err != nil {
+		utils.GetLogger(ctx).Error("error while consume event", zap.Int("retry_count", event.RetryCount), zap.Error(err))
 	}
 
-	return nil
+	// Event which is neither retried nor kept as dead letter is left unacked, so that it is redelivered after visibility timeout
+	if !settled {
+		return
+	}
+	if err := event.Ack(); err != nil {
+		utils.GetLogger(ctx).Error("error while ack event", zap.Error(err))
+	}
+}
+
+// handleEventWithRetry returns false if the failed event is neither retried nor kept as dead letter
+func (t taxicallApp) handleEventWithRetry(ctx context.Context, event entity.Event) (bool, error) {
+	err := t.handleEvent(ctx, event)
+	if err == nil {
+		return true, nil
+	}
+
+	retried, retryErr := app.RetryEvent(ctx, t.service.eventPub, event)
+	if retryErr != nil {
+		return false, fmt.Errorf("app.taxicall.consume: error while publish retry event: %v: %w", retryErr, err)
+	}
+
+	// Retry count is exhausted, keep the event to be inspected & replayed from backoffice
+	if !retried {
+		if dlErr := t.service.deadLetter.CreateDeadLetter(ctx, enum.EventTopic_TAXICALL, event, err); dlErr != nil {
+			return false, fmt.Errorf("app.taxicall.consume: error while create dead letter: %v: %w", dlErr, err)
+		}
+	}
+
+	return true, err
 }
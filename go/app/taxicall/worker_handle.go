@@ -11,9 +11,11 @@ import (
 	"github.com/taco-labs/taco/go/domain/event/command"
 	"github.com/taco-labs/taco/go/domain/value"
 	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/taco-labs/taco/go/metric"
 	"github.com/taco-labs/taco/go/utils"
 	"github.com/taco-labs/taco/go/utils/slices"
 	"github.com/uptrace/bun"
+	"go.uber.org/zap"
 )
 
 func (t taxicallApp) handleEvent(ctx context.Context, event entity.Event) error {
@@ -22,6 +24,7 @@ func (t taxicallApp) handleEvent(ctx context.Context, event entity.Event) error
 	if err != nil {
 		return fmt.Errorf("app.taxicall.handleEvent: error while unmarshal json: %v", err)
 	}
+	ctx = utils.SetTaxiCallRequestId(ctx, taxiProgressCmd.TaxiCallRequestId)
 	if until := time.Until(taxiProgressCmd.DesiredScheduleTime); until > 0 {
 		select {
 		case <-ctx.Done():
@@ -33,7 +36,10 @@ func (t taxicallApp) handleEvent(ctx context.Context, event entity.Event) error
 }
 
 func (t taxicallApp) process(ctx context.Context, retryCount int, cmd command.TaxiCallProcessMessage) error {
-	return t.Run(ctx, func(ctx context.Context, i bun.IDB) error {
+	var dispatchResult string
+	var dispatchCandidates int
+
+	err := t.runWithHistory(ctx, func(ctx context.Context, i bun.IDB) error {
 		//TODO (taekyeom) make taxi call request failed when retry count exceeded
 
 		taxiCallRequest, err := t.repository.taxiCallRequest.GetById(ctx, i, cmd.TaxiCallRequestId)
@@ -43,22 +49,47 @@ func (t taxicallApp) process(ctx context.Context, retryCount int, cmd command.Ta
 
 		// Guard.. commands'state and request's current state must be same
 		if string(taxiCallRequest.CurrentState) != cmd.TaxiCallState {
-			// TODO (taekyeom) logging late message & add metric for anomaly
-			return fmt.Errorf("app.taxicall.process [%s]: taxi call state mismatch %v (actual: %v)",
-				cmd.TaxiCallRequestId, cmd.TaxiCallState, taxiCallRequest.CurrentState)
+			// Dispatch message arriving after accept or cancel is expected, it is not retried
+			utils.GetLogger(ctx).Info("message of previous state is ignored",
+				zap.String("message_state", cmd.TaxiCallState), zap.String("current_state", string(taxiCallRequest.CurrentState)))
+			return nil
 		}
 
 		if taxiCallRequest.CurrentState.Complete() && !cmd.EventTime.Before(taxiCallRequest.UpdateTime) {
+			notifications := []entity.Event{}
+
+			// Drivers who are holding the ticket of unassigned request should know it is no longer available
+			if !taxiCallRequest.DriverId.Valid {
+				ticket, err := t.repository.taxiCallRequest.GetLatestTicketByRequestId(ctx, i, taxiCallRequest.Id)
+				if err != nil && !errors.Is(err, value.ErrNotFound) {
+					return fmt.Errorf("app.taxicall.process [%s]: failed to get ticket: %w", cmd.TaxiCallRequestId, err)
+				}
+				if err == nil {
+					expiredCmds, err := t.ticketExpiredNotifications(ctx, i, taxiCallRequest, ticket)
+					if err != nil {
+						return fmt.Errorf("app.taxicall.process [%s]: failed to get drivers of expired ticket: %w", cmd.TaxiCallRequestId, err)
+					}
+					notifications = append(notifications, expiredCmds...)
+				}
+			}
+
 			if err := t.repository.taxiCallRequest.DeleteTicketByRequestId(ctx, i, taxiCallRequest.Id); err != nil {
 				return fmt.Errorf("app.taxicall.process [%s]: failed to delete ticket: %w", cmd.TaxiCallRequestId, err)
 			}
-			termiationNotification := command.NewUserTaxiCallNotificationCommand(
-				taxiCallRequest,
-				entity.TaxiCallTicket{},
-				entity.DriverTaxiCallContext{},
-			)
-			if err := t.repository.event.BatchCreate(ctx, i, []entity.Event{termiationNotification}); err != nil {
-				return fmt.Errorf("app.taxicall.process [%s]: failed to create taxi call termination notification: %w", cmd.TaxiCallRequestId, err)
+
+			// User cancelled the request by oneself
+			if taxiCallRequest.CurrentState != enum.TaxiCallState_USER_CANCELLED {
+				notifications = append(notifications, command.NewUserTaxiCallNotificationCommand(
+					taxiCallRequest,
+					entity.TaxiCallTicket{},
+					entity.DriverTaxiCallContext{},
+				))
+			}
+			// Nobody to notify if user cancelled the request which has no ticket holders
+			if len(notifications) > 0 {
+				if err := t.repository.event.BatchCreate(ctx, i, notifications); err != nil {
+					return fmt.Errorf("app.taxicall.process [%s]: failed to create taxi call termination notification: %w", cmd.TaxiCallRequestId, err)
+				}
 			}
 			return nil
 		}
@@ -84,22 +115,35 @@ func (t taxicallApp) process(ctx context.Context, retryCount int, cmd command.Ta
 		}
 
 		if cmd.EventTime.UTC().Before(taxiCallTicket.UpdateTime.UTC()) {
-			// TODO (taekyeom) logging late message
+			utils.GetLogger(ctx).Info("late message is ignored",
+				zap.Time("event_time", cmd.EventTime), zap.Time("ticket_update_time", taxiCallTicket.UpdateTime))
 			return nil
 		}
 
+		previousTicket := taxiCallTicket.Copy()
+
 		validTicketOperation := true
 		if !taxiCallTicket.IncreaseAttempt(cmd.DesiredScheduleTime) {
 			validTicketOperation = taxiCallTicket.IncreasePrice(taxiCallRequest.RequestMaxAdditionalPrice, cmd.DesiredScheduleTime)
 		}
 
 		if !validTicketOperation {
+			dispatchResult = "failed"
+
+			fromState := taxiCallRequest.CurrentState
 			if err := taxiCallRequest.UpdateState(cmd.DesiredScheduleTime, enum.TaxiCallState_FAILED); err != nil {
 				return fmt.Errorf("app.taxicall.process [%s]: failed to update state: %w", cmd.TaxiCallRequestId, err)
 			}
 			if err := t.repository.taxiCallRequest.Update(ctx, i, taxiCallRequest); err != nil {
 				return fmt.Errorf("app.taxicall.process: [%s] failed to update call request to failed state: %w", cmd.TaxiCallRequestId, err)
 			}
+			history := entity.NewTaxiCallHistory(taxiCallRequest, fromState, enum.TaxiCallActorType_SYSTEM, "")
+			if err := t.createHistory(ctx, i, history); err != nil {
+				return fmt.Errorf("app.taxicall.process: [%s] failed to create taxi call history: %w", cmd.TaxiCallRequestId, err)
+			}
+			if err := t.service.coupon.ReleaseCoupon(ctx, taxiCallRequest.Id); err != nil {
+				return fmt.Errorf("app.taxicall.process: [%s] failed to release coupon: %w", cmd.TaxiCallRequestId, err)
+			}
 
 			taxiCallCmd := command.NewTaxiCallProgressCommand(taxiCallRequest.Id, taxiCallRequest.CurrentState, cmd.DesiredScheduleTime, cmd.DesiredScheduleTime)
 			if err := t.repository.event.BatchCreate(ctx, i, []entity.Event{taxiCallCmd}); err != nil {
@@ -113,6 +157,14 @@ func (t taxicallApp) process(ctx context.Context, retryCount int, cmd command.Ta
 			return fmt.Errorf("app.taxicall.process: [%s] error while update new ticket: %w", cmd.TaxiCallRequestId, err)
 		}
 
+		history := entity.NewTaxiCallHistory(taxiCallRequest, taxiCallRequest.CurrentState, enum.TaxiCallActorType_SYSTEM, "")
+		history.TicketId = taxiCallTicket.Id
+		history.AdditionalPrice = taxiCallTicket.AdditionalPrice
+		history.CreateTime = cmd.DesiredScheduleTime
+		if err := t.createHistory(ctx, i, history); err != nil {
+			return fmt.Errorf("app.taxicall.process: [%s] error while create taxi call history: %w", cmd.TaxiCallRequestId, err)
+		}
+
 		// Get drivers
 		driverTaxiCallContexts, err := t.repository.taxiCallRequest.
 			GetDriverTaxiCallContextWithinRadius(ctx, i, taxiCallRequest.Departure.Point, taxiCallTicket.GetRadius(), taxiCallTicket.Id, cmd.DesiredScheduleTime)
@@ -120,6 +172,25 @@ func (t taxicallApp) process(ctx context.Context, retryCount int, cmd command.Ta
 			return fmt.Errorf("app.taxicall.process: [%s] error while get driver contexts within radius: %w", cmd.TaxiCallRequestId, err)
 		}
 
+		dispatchCandidates = len(driverTaxiCallContexts)
+		if len(driverTaxiCallContexts) > 0 {
+			dispatchResult = "dispatched"
+		} else {
+			dispatchResult = "no_candidate"
+		}
+
+		// Ticket is replaced with higher price one, drivers who didn't answer the previous ticket should know it is expired
+		expiredCmds := []entity.Event{}
+		if previousTicket.Id != taxiCallTicket.Id {
+			receiverIds := slices.Map(driverTaxiCallContexts, func(dctx entity.DriverTaxiCallContext) string {
+				return dctx.DriverId
+			})
+			expiredCmds, err = t.ticketExpiredNotifications(ctx, i, taxiCallRequest, previousTicket, receiverIds...)
+			if err != nil {
+				return fmt.Errorf("app.taxicall.process: [%s] error while get drivers of expired ticket: %w", cmd.TaxiCallRequestId, err)
+			}
+		}
+
 		if len(driverTaxiCallContexts) > 0 {
 			driverTaxiCallContexts = slices.Map(driverTaxiCallContexts, func(dctx entity.DriverTaxiCallContext) entity.DriverTaxiCallContext {
 				dctx.LastReceivedRequestTicket = taxiCallTicket.Id
@@ -137,10 +208,10 @@ func (t taxicallApp) process(ctx context.Context, retryCount int, cmd command.Ta
 		taxiCallCmd := command.NewTaxiCallProgressCommand(taxiCallRequest.Id, taxiCallRequest.CurrentState,
 			cmd.DesiredScheduleTime, cmd.DesiredScheduleTime.Add(time.Second*10))
 		userCmd := command.NewUserTaxiCallNotificationCommand(taxiCallRequest, taxiCallTicket, entity.DriverTaxiCallContext{})
-		driverCmds := slices.Map(driverTaxiCallContexts, func(i entity.DriverTaxiCallContext) entity.Event {
-			return command.NewDriverTaxiCallNotificationCommand(taxiCallRequest, taxiCallTicket, i)
-		})
-		cmds := append(driverCmds, userCmd, taxiCallCmd)
+		cmds := append(expiredCmds, userCmd, taxiCallCmd)
+		if len(driverTaxiCallContexts) > 0 {
+			cmds = append(cmds, command.NewDriverTaxiCallBulkNotificationCommand(taxiCallRequest, taxiCallTicket, driverTaxiCallContexts))
+		}
 
 		if err := t.repository.event.BatchCreate(ctx, i, cmds); err != nil {
 			return fmt.Errorf("app.taxicall.process: [%s] error while insert notification command events: %w", cmd.TaxiCallRequestId, err)
@@ -148,4 +219,19 @@ func (t taxicallApp) process(ctx context.Context, retryCount int, cmd command.Ta
 
 		return nil
 	})
+
+	if err != nil {
+		return err
+	}
+
+	// Dispatch round is counted after commit like state transitions, so that rolled back round is not counted
+	switch dispatchResult {
+	case "failed":
+		metric.DispatchRounds.WithLabelValues(dispatchResult).Inc()
+	case "dispatched", "no_candidate":
+		metric.DispatchCandidates.Observe(float64(dispatchCandidates))
+		metric.DispatchRounds.WithLabelValues(dispatchResult).Inc()
+	}
+
+	return nil
 }
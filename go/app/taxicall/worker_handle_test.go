@@ -0,0 +1,304 @@
+package taxicall
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/event/command"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/taco-labs/taco/go/metric"
+	"github.com/taco-labs/taco/go/repository"
+	"github.com/uptrace/bun"
+)
+
+type fakeTransactor struct{}
+
+func (f fakeTransactor) Run(ctx context.Context, fn func(context.Context, bun.IDB) error) error {
+	return fn(ctx, nil)
+}
+
+func (f fakeTransactor) RunWithNonRollbackError(ctx context.Context, _ error, fn func(context.Context, bun.IDB) error) error {
+	return fn(ctx, nil)
+}
+
+// fakeTaxiCallRepository records calls of the repository, methods not used by process are not implemented
+type fakeTaxiCallRepository struct {
+	repository.TaxiCallRepository
+
+	request           entity.TaxiCallRequest
+	ticket            *entity.TaxiCallTicket
+	unansweredDrivers []entity.DriverTaxiCallContext
+	candidates        []entity.DriverTaxiCallContext
+
+	updateErr error
+
+	calls          []string
+	updated        []entity.TaxiCallRequest
+	upsertedTicket entity.TaxiCallTicket
+}
+
+func (f *fakeTaxiCallRepository) GetById(ctx context.Context, db bun.IDB, id string) (entity.TaxiCallRequest, error) {
+	return f.request, nil
+}
+
+func (f *fakeTaxiCallRepository) Update(ctx context.Context, db bun.IDB, taxiCallRequest entity.TaxiCallRequest) error {
+	if f.updateErr != nil {
+		return f.updateErr
+	}
+	f.calls = append(f.calls, "Update")
+	f.updated = append(f.updated, taxiCallRequest)
+	return nil
+}
+
+func (f *fakeTaxiCallRepository) GetLatestTicketByRequestId(ctx context.Context, db bun.IDB, requestId string) (entity.TaxiCallTicket, error) {
+	if f.ticket == nil {
+		return entity.TaxiCallTicket{}, value.ErrNotFound
+	}
+	return *f.ticket, nil
+}
+
+func (f *fakeTaxiCallRepository) UpsertTicket(ctx context.Context, db bun.IDB, ticket entity.TaxiCallTicket) error {
+	f.calls = append(f.calls, "UpsertTicket")
+	f.upsertedTicket = ticket
+	return nil
+}
+
+func (f *fakeTaxiCallRepository) DeleteTicketByRequestId(ctx context.Context, db bun.IDB, requestId string) error {
+	f.calls = append(f.calls, "DeleteTicketByRequestId")
+	return nil
+}
+
+func (f *fakeTaxiCallRepository) ListUnansweredDriverTaxiCallContextByTicketId(ctx context.Context, db bun.IDB, ticketId string) ([]entity.DriverTaxiCallContext, error) {
+	return f.unansweredDrivers, nil
+}
+
+func (f *fakeTaxiCallRepository) GetDriverTaxiCallContextWithinRadius(ctx context.Context, db bun.IDB,
+	point value.Point, radius int, ticketId string, t time.Time) ([]entity.DriverTaxiCallContext, error) {
+	return f.candidates, nil
+}
+
+func (f *fakeTaxiCallRepository) BulkUpsertDriverTaxiCallContext(ctx context.Context, db bun.IDB, driverTaxiCallContexts []entity.DriverTaxiCallContext) error {
+	f.calls = append(f.calls, "BulkUpsertDriverTaxiCallContext")
+	return nil
+}
+
+type fakeEventRepository struct {
+	repository.EventRepository
+
+	batches [][]entity.Event
+}
+
+func (f *fakeEventRepository) BatchCreate(ctx context.Context, db bun.IDB, events []entity.Event) error {
+	f.batches = append(f.batches, events)
+	return nil
+}
+
+type fakeTaxiCallHistoryRepository struct {
+	repository.TaxiCallHistoryRepository
+
+	histories []entity.TaxiCallHistory
+}
+
+func (f *fakeTaxiCallHistoryRepository) Create(ctx context.Context, db bun.IDB, history entity.TaxiCallHistory) error {
+	f.histories = append(f.histories, history)
+	return nil
+}
+
+type fakeCouponService struct {
+	couponServiceInterface
+
+	released []string
+}
+
+func (f *fakeCouponService) ReleaseCoupon(ctx context.Context, requestId string) error {
+	f.released = append(f.released, requestId)
+	return nil
+}
+
+type processFixture struct {
+	app      taxicallApp
+	request  *fakeTaxiCallRepository
+	event    *fakeEventRepository
+	history  *fakeTaxiCallHistoryRepository
+	coupon   *fakeCouponService
+	baseTime time.Time
+}
+
+func newProcessFixture(state enum.TaxiCallState) processFixture {
+	baseTime := time.Date(2022, 11, 1, 12, 0, 0, 0, time.UTC)
+	f := processFixture{
+		request: &fakeTaxiCallRepository{
+			request: entity.TaxiCallRequest{
+				Id:                        "request",
+				UserId:                    "user",
+				CurrentState:              state,
+				RequestMaxAdditionalPrice: 5000,
+				Version:                   1,
+				UpdateTime:                baseTime,
+			},
+		},
+		event:    &fakeEventRepository{},
+		history:  &fakeTaxiCallHistoryRepository{},
+		coupon:   &fakeCouponService{},
+		baseTime: baseTime,
+	}
+
+	f.app.Transactor = fakeTransactor{}
+	f.app.repository.taxiCallRequest = f.request
+	f.app.repository.event = f.event
+	f.app.repository.history = f.history
+	f.app.service.coupon = f.coupon
+
+	return f
+}
+
+func (f processFixture) command(scheduleTime time.Time) command.TaxiCallProcessMessage {
+	return command.TaxiCallProcessMessage{
+		TaxiCallRequestId:   f.request.request.Id,
+		TaxiCallState:       string(f.request.request.CurrentState),
+		EventTime:           scheduleTime,
+		DesiredScheduleTime: scheduleTime,
+	}
+}
+
+func eventUris(events []entity.Event) []string {
+	uris := make([]string, 0, len(events))
+	for _, event := range events {
+		uris = append(uris, event.EventUri)
+	}
+	return uris
+}
+
+func TestProcess_StateMismatch(t *testing.T) {
+	f := newProcessFixture(enum.TaxiCallState_USER_CANCELLED)
+	cmd := f.command(f.baseTime)
+	cmd.TaxiCallState = string(enum.TaxiCallState_Requested)
+
+	// Late message is ignored without retry
+	if err := f.app.process(context.Background(), 0, cmd); err != nil {
+		t.Fatal(err)
+	}
+	if len(f.request.calls) != 0 || len(f.event.batches) != 0 {
+		t.Errorf("expected nothing to be changed, got calls %v, batches %d", f.request.calls, len(f.event.batches))
+	}
+}
+
+func TestProcess_UserCancelledWithoutTicketHolders(t *testing.T) {
+	f := newProcessFixture(enum.TaxiCallState_USER_CANCELLED)
+
+	if err := f.app.process(context.Background(), 0, f.command(f.baseTime)); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(f.request.calls) != 1 || f.request.calls[0] != "DeleteTicketByRequestId" {
+		t.Errorf("expected ticket to be deleted only, got %v", f.request.calls)
+	}
+	if len(f.event.batches) != 0 {
+		t.Errorf("expected no notification batch, got %d", len(f.event.batches))
+	}
+}
+
+func TestProcess_UserCancelledWithTicketHolders(t *testing.T) {
+	f := newProcessFixture(enum.TaxiCallState_USER_CANCELLED)
+	f.request.ticket = &entity.TaxiCallTicket{Id: "ticket", TaxiCallRequestId: "request", Attempt: 1}
+	f.request.unansweredDrivers = []entity.DriverTaxiCallContext{{DriverId: "driver"}}
+
+	if err := f.app.process(context.Background(), 0, f.command(f.baseTime)); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(f.event.batches) != 1 {
+		t.Fatalf("expected 1 notification batch, got %d", len(f.event.batches))
+	}
+	uris := eventUris(f.event.batches[0])
+	if len(uris) != 1 || uris[0] != command.EventUri_DriverTaxiCallBulkNotification {
+		t.Errorf("expected ticket expired notification to drivers only, got %v", uris)
+	}
+}
+
+func TestProcess_DriverCancelledNotifiesUser(t *testing.T) {
+	f := newProcessFixture(enum.TaxiCallState_DRIVER_CANCELLED)
+
+	if err := f.app.process(context.Background(), 0, f.command(f.baseTime)); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(f.event.batches) != 1 {
+		t.Fatalf("expected 1 notification batch, got %d", len(f.event.batches))
+	}
+	uris := eventUris(f.event.batches[0])
+	if len(uris) != 1 || uris[0] != command.EventUri_UserTaxiCallNotification {
+		t.Errorf("expected user notification, got %v", uris)
+	}
+}
+
+func TestProcess_FailedWhenPriceLimitExceeded(t *testing.T) {
+	f := newProcessFixture(enum.TaxiCallState_Requested)
+	f.request.request.RequestMaxAdditionalPrice = 0
+	f.request.ticket = &entity.TaxiCallTicket{
+		Id:                "ticket",
+		TaxiCallRequestId: "request",
+		Attempt:           entity.AttemptLimit,
+		CreateTime:        f.baseTime,
+		UpdateTime:        f.baseTime,
+	}
+	scheduleTime := f.baseTime.Add(10 * time.Second)
+
+	if err := f.app.process(context.Background(), 0, f.command(scheduleTime)); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(f.request.updated) != 1 || f.request.updated[0].CurrentState != enum.TaxiCallState_FAILED {
+		t.Fatalf("expected request to be failed, got %+v", f.request.updated)
+	}
+	if len(f.history.histories) != 1 || f.history.histories[0].ToState != enum.TaxiCallState_FAILED {
+		t.Errorf("expected history of failure, got %+v", f.history.histories)
+	}
+	if len(f.coupon.released) != 1 {
+		t.Errorf("expected coupon to be released, got %v", f.coupon.released)
+	}
+	if len(f.event.batches) != 1 || len(f.event.batches[0]) != 1 || f.event.batches[0][0].EventUri != command.EventUri_TaxiCallProcess {
+		t.Errorf("expected process event of failed state, got %+v", f.event.batches)
+	}
+}
+
+func TestProcess_DispatchRoundCountedAfterCommit(t *testing.T) {
+	newFailingFixture := func() processFixture {
+		f := newProcessFixture(enum.TaxiCallState_Requested)
+		f.request.request.RequestMaxAdditionalPrice = 0
+		f.request.ticket = &entity.TaxiCallTicket{
+			Id:                "ticket",
+			TaxiCallRequestId: "request",
+			Attempt:           entity.AttemptLimit,
+			CreateTime:        f.baseTime,
+			UpdateTime:        f.baseTime,
+		}
+		return f
+	}
+	failedRounds := metric.DispatchRounds.WithLabelValues("failed")
+	scheduleTime := newFailingFixture().baseTime.Add(10 * time.Second)
+
+	rolledBack := newFailingFixture()
+	rolledBack.request.updateErr = errors.New("version conflict")
+	before := testutil.ToFloat64(failedRounds)
+	if err := rolledBack.app.process(context.Background(), 0, rolledBack.command(scheduleTime)); err == nil {
+		t.Fatal("expected update error")
+	}
+	if actual := testutil.ToFloat64(failedRounds); actual != before {
+		t.Errorf("expected rolled back round not to be counted, got %v -> %v", before, actual)
+	}
+
+	committed := newFailingFixture()
+	if err := committed.app.process(context.Background(), 0, committed.command(scheduleTime)); err != nil {
+		t.Fatal(err)
+	}
+	if actual := testutil.ToFloat64(failedRounds); actual != before+1 {
+		t.Errorf("expected committed round to be counted, got %v -> %v", before, actual)
+	}
+}
@@ -0,0 +1,72 @@
+package taxicall
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/event/command"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+)
+
+type fakeEventPublishService struct {
+	err error
+}
+
+func (f fakeEventPublishService) SendMessage(ctx context.Context, event entity.Event) error {
+	return f.err
+}
+
+type fakeDeadLetterService struct {
+	err    error
+	events []entity.Event
+}
+
+func (f *fakeDeadLetterService) CreateDeadLetter(ctx context.Context, topic enum.EventTopic, event entity.Event, cause error) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.events = append(f.events, event)
+	return nil
+}
+
+func TestHandleEventWithRetry(t *testing.T) {
+	// Malformed payload always fails
+	event := entity.Event{
+		MessageId: "message",
+		EventUri:  command.EventUri_TaxiCallProcess,
+		Payload:   []byte("{"),
+	}
+	exhausted := event
+	exhausted.RetryCount = command.RetryPolicyOf(command.EventUri_TaxiCallProcess).MaxRetryCount
+
+	testCases := []struct {
+		name          string
+		event         entity.Event
+		publishErr    error
+		deadLetterErr error
+		settled       bool
+	}{
+		{"retried", event, nil, nil, true},
+		{"retry publish failure", event, errors.New("publish failed"), nil, false},
+		{"dead lettered", exhausted, nil, nil, true},
+		{"dead letter failure", exhausted, nil, errors.New("dead letter failed"), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newProcessFixture(enum.TaxiCallState_Requested)
+			f.app.service.eventPub = fakeEventPublishService{err: tc.publishErr}
+			f.app.service.deadLetter = &fakeDeadLetterService{err: tc.deadLetterErr}
+
+			settled, err := f.app.handleEventWithRetry(context.Background(), tc.event)
+			if err == nil {
+				t.Fatal("expected error of the event")
+			}
+			if settled != tc.settled {
+				t.Errorf("expected settled %v, got %v", tc.settled, settled)
+			}
+		})
+	}
+}
@@ -77,6 +77,24 @@ func WithTaxiCallService(svc taxiCallInterface) userAppOption {
 	}
 }
 
+func WithReferralService(svc referralServiceInterface) userAppOption {
+	return func(ua *userApp) {
+		ua.service.referral = svc
+	}
+}
+
+func WithCouponService(svc couponServiceInterface) userAppOption {
+	return func(ua *userApp) {
+		ua.service.coupon = svc
+	}
+}
+
+func WithCorporateService(svc corporateServiceInterface) userAppOption {
+	return func(ua *userApp) {
+		ua.service.corporate = svc
+	}
+}
+
 func (u userApp) validateApp() error {
 	if u.Transactor == nil {
 		return errors.New("user app need transator")
@@ -122,5 +140,17 @@ func (u userApp) validateApp() error {
 		return errors.New("user app need taxi call service")
 	}
 
+	if u.service.referral == nil {
+		return errors.New("user app need referral service")
+	}
+
+	if u.service.coupon == nil {
+		return errors.New("user app need coupon service")
+	}
+
+	if u.service.corporate == nil {
+		return errors.New("user app need corporate service")
+	}
+
 	return nil
 }
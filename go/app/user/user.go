@@ -18,6 +18,9 @@ import (
 	"github.com/uptrace/bun"
 )
 
+// Referral code is generated again on collision up to this attempts
+const referralCodeMaxAttempts = 3
+
 type sessionServiceInterface interface {
 	RevokeSessionByUserId(context.Context, string) error
 	CreateSession(context.Context, entity.UserSession) error
@@ -27,6 +30,8 @@ type pushServiceInterface interface {
 	CreatePushToken(context.Context, request.CreatePushTokenRequest) (entity.PushToken, error)
 	UpdatePushToken(context.Context, request.UpdatePushTokenRequest) error
 	DeletePushToken(context.Context, string) error
+	GetNotificationPreference(context.Context, string) (entity.NotificationPreference, error)
+	UpdateNotificationPreference(context.Context, request.UpdateNotificationPreferenceRequest) (entity.NotificationPreference, error)
 }
 
 type taxiCallInterface interface {
@@ -34,6 +39,19 @@ type taxiCallInterface interface {
 	LatestUserTaxiCallRequest(context.Context, string) (entity.TaxiCallRequest, error)
 	CreateTaxiCallRequest(context.Context, string, entity.UserPayment, request.CreateTaxiCallRequest) (entity.TaxiCallRequest, error)
 	CancelTaxiCallRequest(context.Context, string, string) error
+	ListUserTaxiCallHistory(context.Context, string, string) ([]entity.TaxiCallHistory, error)
+}
+
+type referralServiceInterface interface {
+	CreateReferral(context.Context, entity.User, string) error
+}
+
+type couponServiceInterface interface {
+	ListUserCoupon(context.Context, string) ([]entity.Coupon, error)
+}
+
+type corporateServiceInterface interface {
+	ListUserCorporate(context.Context, string) ([]entity.Corporate, error)
 }
 
 type userApp struct {
@@ -52,6 +70,9 @@ type userApp struct {
 		location  service.LocationService
 		push      pushServiceInterface
 		taxiCall  taxiCallInterface
+		referral  referralServiceInterface
+		coupon    couponServiceInterface
+		corporate corporateServiceInterface
 	}
 }
 
@@ -175,15 +196,30 @@ func (u userApp) Signup(ctx context.Context, req request.UserSignupRequest) (ent
 			AppOs:         enum.OsTypeFromString(req.AppOs),
 			AppVersion:    req.AppVersion,
 			AppFcmToken:   req.AppFcmToken,
+			Language:      enum.LanguageFromString(req.Language),
 			UserUniqueKey: req.Phone,
 			CreateTime:    requestTime,
 			UpdateTime:    requestTime,
 			DeleteTime:    time.Time{},
 		}
-		if err = u.repository.user.CreateUser(ctx, i, newUser); err != nil {
+		// Referral code is random, so that it is generated again on collision
+		for attempt := 1; ; attempt++ {
+			newUser.ReferralCode = entity.NewReferralCode()
+			err = u.repository.user.CreateUser(ctx, i, newUser)
+			if !errors.Is(err, value.ErrAlreadyExists) || attempt >= referralCodeMaxAttempts {
+				break
+			}
+		}
+		if err != nil {
 			return fmt.Errorf("app.User.Signup: error while create user:\n %w", err)
 		}
 
+		if req.ReferralCode != "" {
+			if err := u.service.referral.CreateReferral(ctx, newUser, req.ReferralCode); err != nil {
+				return fmt.Errorf("app.User.Signup: error while create referral: %w", err)
+			}
+		}
+
 		// Create push token
 		if _, err := u.service.push.CreatePushToken(ctx, request.CreatePushTokenRequest{
 			PrincipalId: newUser.Id,
@@ -229,6 +265,7 @@ func (u userApp) UpdateUser(ctx context.Context, req request.UserUpdateRequest)
 		}
 		user.AppOs = enum.OsTypeFromString(req.AppOs)
 		user.AppVersion = req.AppVersion
+		user.Language = enum.LanguageFromString(req.Language)
 		user.AppFcmToken = req.AppFcmToken
 		user.UpdateTime = requestTime
 
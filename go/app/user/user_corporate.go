@@ -0,0 +1,17 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+)
+
+func (u userApp) ListCorporate(ctx context.Context, userId string) ([]entity.Corporate, error) {
+	corporates, err := u.service.corporate.ListUserCorporate(ctx, userId)
+	if err != nil {
+		return []entity.Corporate{}, fmt.Errorf("app.user.ListCorporate: error while list corporates: %w", err)
+	}
+
+	return corporates, nil
+}
@@ -0,0 +1,17 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+)
+
+func (u userApp) ListCoupon(ctx context.Context, userId string) ([]entity.Coupon, error) {
+	coupons, err := u.service.coupon.ListUserCoupon(ctx, userId)
+	if err != nil {
+		return []entity.Coupon{}, fmt.Errorf("app.user.ListCoupon: error while list coupons: %w", err)
+	}
+
+	return coupons, nil
+}
@@ -0,0 +1,27 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/request"
+)
+
+func (u userApp) GetNotificationPreference(ctx context.Context, userId string) (entity.NotificationPreference, error) {
+	preference, err := u.service.push.GetNotificationPreference(ctx, userId)
+	if err != nil {
+		return entity.NotificationPreference{}, fmt.Errorf("app.user.GetNotificationPreference: error while get notification preference: %w", err)
+	}
+
+	return preference, nil
+}
+
+func (u userApp) UpdateNotificationPreference(ctx context.Context, req request.UpdateNotificationPreferenceRequest) (entity.NotificationPreference, error) {
+	preference, err := u.service.push.UpdateNotificationPreference(ctx, req)
+	if err != nil {
+		return entity.NotificationPreference{}, fmt.Errorf("app.user.UpdateNotificationPreference: error while update notification preference: %w", err)
+	}
+
+	return preference, nil
+}
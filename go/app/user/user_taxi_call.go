@@ -23,6 +23,11 @@ func (u userApp) GetLatestTaxiCallRequest(ctx context.Context, userId string) (e
 func (u userApp) CreateTaxiCallRequest(ctx context.Context, req request.CreateTaxiCallRequest) (entity.TaxiCallRequest, error) {
 	userId := utils.GetUserId(ctx)
 
+	// Taxi call billed to corporate does not need user payment
+	if req.CorporateId != "" {
+		return u.service.taxiCall.CreateTaxiCallRequest(ctx, userId, entity.UserPayment{}, req)
+	}
+
 	var userPayment entity.UserPayment
 	err := u.Run(ctx, func(ctx context.Context, i bun.IDB) error {
 		// check payment
@@ -51,3 +56,8 @@ func (u userApp) CancelTaxiCallRequest(ctx context.Context, taxiCallId string) e
 	userId := utils.GetUserId(ctx)
 	return u.service.taxiCall.CancelTaxiCallRequest(ctx, userId, taxiCallId)
 }
+
+func (u userApp) ListTaxiCallHistory(ctx context.Context, taxiCallId string) ([]entity.TaxiCallHistory, error) {
+	userId := utils.GetUserId(ctx)
+	return u.service.taxiCall.ListUserTaxiCallHistory(ctx, userId, taxiCallId)
+}
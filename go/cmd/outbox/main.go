@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/app/outbox"
+	"github.com/taco-labs/taco/go/config"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/taco-labs/taco/go/repository"
+	"github.com/taco-labs/taco/go/service"
+	"github.com/taco-labs/taco/go/tracing"
+	"github.com/taco-labs/taco/go/utils"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"github.com/uptrace/bun/extra/bundebug"
+	"gocloud.dev/pubsub"
+	_ "gocloud.dev/pubsub/awssnssqs"
+)
+
+// Outbox relay publishes events stored in outbox table to event bus.
+// Relays claim events with row lock, so that any number of replicas can be run along with api servers.
+func main() {
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+
+	config, err := config.NewOutboxConfig(ctx)
+	if err != nil {
+		fmt.Println("Failed to initialize taco outbox config: ", err)
+		os.Exit(1)
+	}
+
+	if err := utils.InitLogger(config.Log.Level, config.Log.Format); err != nil {
+		fmt.Println("Failed to initialize logger: ", err)
+		os.Exit(1)
+	}
+	defer utils.Logger.Sync()
+
+	dsn := fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=disable&search_path=%s",
+		config.Database.UserName,
+		config.Database.Password,
+		config.Database.Host,
+		config.Database.Port,
+		config.Database.Database,
+		config.Database.Schema,
+	)
+
+	sqldb := sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(dsn)))
+
+	db := bun.NewDB(sqldb, pgdialect.New())
+
+	if config.Log.Query {
+		hook := bundebug.NewQueryHook(bundebug.WithVerbose(false))
+		db.AddQueryHook(hook)
+	}
+
+	shutdownTracing := func(context.Context) error { return nil }
+	if config.Tracing.Enabled {
+		shutdownTracing, err = tracing.Init(ctx, "taco-outbox", config.Tracing.Endpoint, config.Tracing.SampleRatio)
+		if err != nil {
+			fmt.Println("Failed to initialize tracing: ", err)
+			os.Exit(1)
+		}
+		db.AddQueryHook(tracing.NewQueryHook())
+	}
+
+	transactor := app.NewDefaultTranscator(db)
+
+	// Init repositories
+
+	eventRepository := repository.NewEventRepository()
+	deadLetterRepository := repository.NewDeadLetterRepository()
+
+	// Init services
+
+	notificationPublisherService, closeNotificationPublisher, err := newEventPublisher(ctx, config.EventBus, db, config.NotificationTopic)
+	if err != nil {
+		fmt.Println("Failed to initialize notification publisher topic: ", err)
+		os.Exit(1)
+	}
+	defer closeNotificationPublisher()
+
+	taxicallPublisherService, closeTaxicallPublisher, err := newEventPublisher(ctx, config.EventBus, db, config.TaxicallTopic)
+	if err != nil {
+		fmt.Println("Failed to initialize taxicall publisher topic: ", err)
+		os.Exit(1)
+	}
+	defer closeTaxicallPublisher()
+
+	// Init apps
+
+	notificationOutboxApp, err := outbox.NewOutboxApp(
+		outbox.WithTransactor(transactor),
+		outbox.WithEventRepository(eventRepository),
+		outbox.WithEventPublishService(notificationPublisherService),
+		outbox.WithDeadLetterRepository(deadLetterRepository),
+		outbox.WithName("notification"),
+		outbox.WithTopic(enum.EventTopic_NOTIFICATION),
+		outbox.WithTargetEventUirs(config.NotificationOutbox.EventUris),
+		outbox.WithPollInterval(config.NotificationOutbox.PollInterval),
+		outbox.WithMaxMessages(config.NotificationOutbox.MaxMessages),
+	)
+	if err != nil {
+		fmt.Println("Failed to initialize notification outbox app: ", err)
+		os.Exit(1)
+	}
+
+	taxicallOutboxApp, err := outbox.NewOutboxApp(
+		outbox.WithTransactor(transactor),
+		outbox.WithEventRepository(eventRepository),
+		outbox.WithEventPublishService(taxicallPublisherService),
+		outbox.WithDeadLetterRepository(deadLetterRepository),
+		outbox.WithName("taxicall"),
+		outbox.WithTopic(enum.EventTopic_TAXICALL),
+		outbox.WithTargetEventUirs(config.TaxicallOutbox.EventUris),
+		outbox.WithPollInterval(config.TaxicallOutbox.PollInterval),
+		outbox.WithMaxMessages(config.TaxicallOutbox.MaxMessages),
+	)
+	if err != nil {
+		fmt.Println("Failed to initialize taxicall outbox app: ", err)
+		os.Exit(1)
+	}
+
+	// Init servers
+
+	healthEcho := echo.New()
+	healthEcho.HideBanner = true
+	healthEcho.GET("/healthz", func(e echo.Context) error {
+		return e.String(http.StatusOK, "OK")
+	})
+	// Relay is not ready if it can not claim events
+	healthEcho.GET("/readyz", func(e echo.Context) error {
+		if err := db.PingContext(e.Request().Context()); err != nil {
+			return e.String(http.StatusServiceUnavailable, err.Error())
+		}
+		return e.String(http.StatusOK, "OK")
+	})
+
+	healthEcho.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
+
+	// Runnables are started in registered order and stopped in reverse order
+	lifecycle := app.NewLifecycleManager(config.Lifecycle.StopTimeout)
+	lifecycle.Add("Notification Outbox Relay", notificationOutboxApp)
+	lifecycle.Add("Taxi Call Outbox Relay", taxicallOutboxApp)
+	lifecycle.Add("Health API", app.NewServerRunnable("health server", healthServer{
+		echo: healthEcho,
+		port: config.OutboxRelay.HealthPort,
+	}))
+
+	if err := lifecycle.Start(ctx); err != nil {
+		fmt.Printf("Failed to start [Taco-Outbox] service: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Use a buffered channel to avoid missing signals as recommended for signal.Notify
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	<-quit
+	fmt.Println("shutting down [Taco-Outbox] service... because of interrupt")
+
+	// In-flight batches are committed before cancelling context
+	if err := lifecycle.Stop(); err != nil {
+		fmt.Printf("Failed to stop [Taco-Outbox] service gracefully: %v\n", err)
+	}
+
+	// Spans of drained works are flushed after runnables are stopped
+	if err := shutdownTracing(ctx); err != nil {
+		fmt.Printf("Failed to flush traces: %v\n", err)
+	}
+	cancel()
+}
+
+func newEventPublisher(ctx context.Context, eventBusConfig config.EventBusConfig, db *bun.DB,
+	topicConfig config.TopicConfig) (service.EventPublishService, func(), error) {
+	switch eventBusConfig.Type {
+	case config.EventBusType_SQS:
+		publisher, err := pubsub.OpenTopic(ctx, topicConfig.GetSqsUri())
+		if err != nil {
+			return nil, nil, err
+		}
+		return service.NewSqsPubService(publisher), func() { publisher.Shutdown(ctx) }, nil
+	case config.EventBusType_POSTGRES:
+		return service.NewPostgresPubService(db, topicConfig.Uri), func() {}, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown event bus type: %s", eventBusConfig.Type)
+	}
+}
+
+type healthServer struct {
+	echo *echo.Echo
+	port int
+}
+
+func (h healthServer) Run(ctx context.Context) error {
+	return h.echo.Start(fmt.Sprintf("0.0.0.0:%d", h.port))
+}
+
+func (h healthServer) Stop(ctx context.Context) error {
+	return h.echo.Shutdown(ctx)
+}
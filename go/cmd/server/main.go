@@ -4,29 +4,43 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"net/http"
 	"os"
 	"os/signal"
+	"syscall"
 
 	firebase "firebase.google.com/go"
 	"github.com/taco-labs/taco/go/app"
+	"github.com/taco-labs/taco/go/app/corporate"
+	"github.com/taco-labs/taco/go/app/coupon"
+	"github.com/taco-labs/taco/go/app/deadletter"
+	"github.com/taco-labs/taco/go/app/demand"
 	"github.com/taco-labs/taco/go/app/driver"
+	"github.com/taco-labs/taco/go/app/driverduty"
 	"github.com/taco-labs/taco/go/app/driversession"
+	"github.com/taco-labs/taco/go/app/idempotency"
 	"github.com/taco-labs/taco/go/app/outbox"
 	"github.com/taco-labs/taco/go/app/push"
+	"github.com/taco-labs/taco/go/app/referral"
 	"github.com/taco-labs/taco/go/app/taxicall"
 	"github.com/taco-labs/taco/go/app/user"
 	"github.com/taco-labs/taco/go/app/usersession"
 	"github.com/taco-labs/taco/go/config"
+	"github.com/taco-labs/taco/go/domain/value/enum"
 	"github.com/taco-labs/taco/go/repository"
+	"github.com/taco-labs/taco/go/server"
 	backofficeserver "github.com/taco-labs/taco/go/server/backoffice"
 	driverserver "github.com/taco-labs/taco/go/server/driver"
 	userserver "github.com/taco-labs/taco/go/server/user"
 	"github.com/taco-labs/taco/go/service"
+	"github.com/taco-labs/taco/go/tracing"
+	"github.com/taco-labs/taco/go/utils"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect/pgdialect"
 	"github.com/uptrace/bun/driver/pgdriver"
 	"github.com/uptrace/bun/extra/bundebug"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
+	"gocloud.dev/blob"
+	_ "gocloud.dev/blob/s3blob"
 	"gocloud.dev/pubsub"
 	_ "gocloud.dev/pubsub/awssnssqs"
 )
@@ -41,6 +55,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := utils.InitLogger(config.Log.Level, config.Log.Format); err != nil {
+		fmt.Println("Failed to initialize logger: ", err)
+		os.Exit(1)
+	}
+	defer utils.Logger.Sync()
+
 	dsn := fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=disable&search_path=%s",
 		config.Database.UserName,
 		config.Database.Password,
@@ -59,6 +79,16 @@ func main() {
 		db.AddQueryHook(hook)
 	}
 
+	shutdownTracing := func(context.Context) error { return nil }
+	if config.Tracing.Enabled {
+		shutdownTracing, err = tracing.Init(ctx, "taco-server", config.Tracing.Endpoint, config.Tracing.SampleRatio)
+		if err != nil {
+			fmt.Println("Failed to initialize tracing: ", err)
+			os.Exit(1)
+		}
+		db.AddQueryHook(tracing.NewQueryHook())
+	}
+
 	transactor := app.NewDefaultTranscator(db)
 
 	// Init repositories
@@ -70,8 +100,13 @@ func main() {
 	userPaymentRepository := repository.NewUserPaymentRepository()
 
 	taxiCallRequestRepository := repository.NewTaxiCallRepository()
+	taxiCallHistoryRepository := repository.NewTaxiCallHistoryRepository()
 
 	driverRepository := repository.NewDriverRepository()
+	driverDocumentRepository := repository.NewDriverDocumentRepository()
+	vehicleRepository := repository.NewVehicleRepository()
+	driverDutySessionRepository := repository.NewDriverDutySessionRepository()
+	demandRepository := repository.NewDemandRepository()
 	driverLocationRepository := repository.NewDriverLocationRepository()
 	driverSettlementAccountRepository := repository.NewDriverSettlementAccountRepository()
 	driverSessionRepository := repository.NewDriverSessionRepository()
@@ -79,6 +114,17 @@ func main() {
 	eventRepository := repository.NewEventRepository()
 
 	pushTokenRepository := repository.NewPushTokenRepository()
+	notificationPreferenceRepository := repository.NewNotificationPreferenceRepository()
+
+	couponRepository := repository.NewCouponRepository()
+
+	referralRepository := repository.NewReferralRepository()
+
+	corporateRepository := repository.NewCorporateRepository()
+
+	idempotencyKeyRepository := repository.NewIdempotencyKeyRepository()
+
+	deadLetterRepository := repository.NewDeadLetterRepository()
 
 	// Init services
 
@@ -100,7 +146,14 @@ func main() {
 		config.LocationService.ApiSecret,
 	)
 
-	fileUploadService := service.NewMockFileUploadService()
+	bucket, err := blob.OpenBucket(ctx, config.FileUpload.BucketUri)
+	if err != nil {
+		fmt.Printf("Failed to open file upload bucket: %v\n", err)
+		os.Exit(1)
+	}
+	defer bucket.Close()
+
+	fileUploadService := service.NewBlobFileUploadService(bucket, config.FileUpload.DownloadExpire)
 
 	// TODO(taekyeom) Replace mock to real one
 	tossPaymentService := service.NewTossPaymentService(
@@ -121,115 +174,165 @@ func main() {
 	}
 	notificationService := service.NewFirebaseNotificationService(messagingClient, config.Firebase.DryRun)
 
-	notificationSubscriber, err := pubsub.OpenSubscription(ctx, config.NotificationTopic.GetSqsUri())
+	realtimeService := service.NewInMemoryRealtimeService(config.Realtime.BufferSize)
+
+	notificationPublisherService, closeNotificationPublisher, err := newEventPublisher(ctx, config.EventBus, db, config.NotificationTopic)
 	if err != nil {
-		fmt.Println("Failed to initialize notification sqs subscription topic: ", err)
+		fmt.Println("Failed to initialize notification publisher topic: ", err)
 		os.Exit(1)
 	}
-	defer notificationSubscriber.Shutdown(ctx)
-	notificationSubscriberService := service.NewSqsSubService(notificationSubscriber)
+	defer closeNotificationPublisher()
 
-	notificationPublisher, err := pubsub.OpenTopic(ctx, config.NotificationTopic.GetSqsUri())
+	notificationSubscriberService, closeNotificationSubscriber, err := newEventSubscriber(ctx, config.EventBus, db, config.NotificationTopic)
 	if err != nil {
-		fmt.Println("Failed to initialize notification sqs publisher topic: ", err)
+		fmt.Println("Failed to initialize notification subscription topic: ", err)
 		os.Exit(1)
 	}
-	defer notificationPublisher.Shutdown(ctx)
-	notificationPublisherService := service.NewSqsPubService(notificationPublisher)
+	defer closeNotificationSubscriber()
 
-	taxicallSubscriber, err := pubsub.OpenSubscription(ctx, config.TaxicallTopic.GetSqsUri())
+	taxicallPublisherService, closeTaxicallPublisher, err := newEventPublisher(ctx, config.EventBus, db, config.TaxicallTopic)
 	if err != nil {
-		fmt.Println("Failed to initialize taxicall sqs subscription topic: ", err)
+		fmt.Println("Failed to initialize taxicall publisher topic: ", err)
 		os.Exit(1)
 	}
-	defer taxicallSubscriber.Shutdown(ctx)
-	taxicallSubscriberService := service.NewSqsSubService(taxicallSubscriber)
+	defer closeTaxicallPublisher()
 
-	taxicallPublisher, err := pubsub.OpenTopic(ctx, config.TaxicallTopic.GetSqsUri())
+	taxicallSubscriberService, closeTaxicallSubscriber, err := newEventSubscriber(ctx, config.EventBus, db, config.TaxicallTopic)
 	if err != nil {
-		fmt.Println("Failed to initialize taxicall sqs publisher topic: ", err)
+		fmt.Println("Failed to initialize taxicall subscription topic: ", err)
 		os.Exit(1)
 	}
-	defer taxicallPublisher.Shutdown(ctx)
-	taxicallPublisherService := service.NewSqsPubService(taxicallPublisher)
+	defer closeTaxicallSubscriber()
 
 	// Init apps
+	templateRegistry, err := push.NewTemplateRegistry(config.NotificationTemplate.Path)
+	if err != nil {
+		fmt.Printf("Failed to load notification templates: %v\n", err)
+		os.Exit(1)
+	}
+
+	deadLetterApp, err := deadletter.NewDeadLetterApp(
+		deadletter.WithTransactor(transactor),
+		deadletter.WithDeadLetterRepository(deadLetterRepository),
+		deadletter.WithEventPublisherService(enum.EventTopic_TAXICALL, taxicallPublisherService),
+		deadletter.WithEventPublisherService(enum.EventTopic_NOTIFICATION, notificationPublisherService),
+	)
+	if err != nil {
+		fmt.Printf("Failed to setup dead letter app: %v\n", err)
+		os.Exit(1)
+	}
+
 	pushApp, err := push.NewPushApp(
 		push.WithTransactor(transactor),
 		push.WithRouteService(mapRouteService),
 		push.WithNotificationService(notificationService),
+		push.WithRealtimeService(realtimeService),
 		push.WithPushTokenRepository(pushTokenRepository),
+		push.WithNotificationPreferenceRepository(notificationPreferenceRepository),
+		push.WithUserRepository(userRepository),
+		push.WithDriverRepository(driverRepository),
+		push.WithTemplateRegistry(templateRegistry),
+		push.WithSmsSenderService(smsSenderService),
 		push.WithEventSubscribeService(notificationSubscriberService),
 		push.WithEventPublisherService(notificationPublisherService),
+		push.WithDeadLetterService(deadLetterApp),
+		push.WithConcurrency(config.PushWorker.Concurrency),
 	)
 	if err != nil {
 		fmt.Printf("Failed to setup push app: %v\n", err)
 		os.Exit(1)
 	}
 
-	if err := pushApp.Start(ctx); err != nil {
-		fmt.Printf("Failed to start push app event loop: %v\n", err)
+	couponApp, err := coupon.NewCouponApp(
+		coupon.WithTransactor(transactor),
+		coupon.WithCouponRepository(couponRepository),
+	)
+	if err != nil {
+		fmt.Printf("Failed to setup coupon app: %v\n", err)
+		os.Exit(1)
+	}
+
+	referralApp, err := referral.NewReferralApp(
+		referral.WithTransactor(transactor),
+		referral.WithReferralRepository(referralRepository),
+		referral.WithUserRepository(userRepository),
+		referral.WithUserPaymentRepository(userPaymentRepository),
+		referral.WithCouponService(couponApp),
+	)
+	if err != nil {
+		fmt.Printf("Failed to setup referral app: %v\n", err)
+		os.Exit(1)
+	}
+
+	corporateApp, err := corporate.NewCorporateApp(
+		corporate.WithTransactor(transactor),
+		corporate.WithCorporateRepository(corporateRepository),
+	)
+	if err != nil {
+		fmt.Printf("Failed to setup corporate app: %v\n", err)
 		os.Exit(1)
 	}
-	defer pushApp.Stop(ctx)
 
 	taxicallApp, err := taxicall.NewTaxicallApp(
 		taxicall.WithTransactor(transactor),
 		taxicall.WithDriverLocationRepository(driverLocationRepository),
+		taxicall.WithVehicleRepository(vehicleRepository),
+		taxicall.WithTaxiCallHistoryRepository(taxiCallHistoryRepository),
 		taxicall.WithTaxiCallRequestRepository(taxiCallRequestRepository),
 		taxicall.WithEventRepository(eventRepository),
 		taxicall.WithRouteServie(mapRouteService),
 		taxicall.WithLocationService(locationService),
 		taxicall.WithEventPublisherService(taxicallPublisherService),
 		taxicall.WithEventSubscriberService(taxicallSubscriberService),
+		taxicall.WithCouponService(couponApp),
+		taxicall.WithReferralService(referralApp),
+		taxicall.WithCorporateService(corporateApp),
+		taxicall.WithDeadLetterService(deadLetterApp),
 	)
 	if err != nil {
 		fmt.Printf("Failed to start taxi call app: %v\n", err)
 		os.Exit(1)
 	}
-	defer taxicallApp.Shutdown(ctx)
 
-	notificationOutboxApp, err := outbox.NewOutboxApp(
-		outbox.WithTransactor(transactor),
-		outbox.WithEventRepository(eventRepository),
-		outbox.WithEventPublishService(notificationPublisherService),
-		outbox.WithTargetEventUirs(config.NotificationOutbox.EventUris),
-		outbox.WithPollInterval(config.NotificationOutbox.PollInterval),
-		outbox.WithMaxMessages(config.NotificationOutbox.MaxMessages),
-	)
-	if err != nil {
-		fmt.Println("Failed to initialize notification outbox app: ", err)
-		os.Exit(1)
-	}
-
-	if err := notificationOutboxApp.Start(ctx); err != nil {
-		fmt.Println("Failed to start notification outbox app: ", err)
-		os.Exit(1)
-	}
-	defer notificationOutboxApp.Shuwdown()
-
-	taxicallOutboxApp, err := outbox.NewOutboxApp(
-		outbox.WithTransactor(transactor),
-		outbox.WithEventRepository(eventRepository),
-		outbox.WithEventPublishService(taxicallPublisherService),
-		outbox.WithTargetEventUirs(config.TaxicallOutbox.EventUris),
-		outbox.WithPollInterval(config.TaxicallOutbox.PollInterval),
-		outbox.WithMaxMessages(config.TaxicallOutbox.MaxMessages),
-	)
-	if err != nil {
-		fmt.Println("Failed to initialize taxicall outbox app: ", err)
-		os.Exit(1)
-	}
+	// Runnables are started in registered order and stopped in reverse order
+	lifecycle := app.NewLifecycleManager(config.Lifecycle.StopTimeout)
+
+	// Outbox relays are run by standalone outbox command if not embedded
+	if config.OutboxRelay.Embedded {
+		notificationOutboxApp, err := outbox.NewOutboxApp(
+			outbox.WithTransactor(transactor),
+			outbox.WithEventRepository(eventRepository),
+			outbox.WithEventPublishService(notificationPublisherService),
+			outbox.WithDeadLetterRepository(deadLetterRepository),
+			outbox.WithName("notification"),
+			outbox.WithTopic(enum.EventTopic_NOTIFICATION),
+			outbox.WithTargetEventUirs(config.NotificationOutbox.EventUris),
+			outbox.WithPollInterval(config.NotificationOutbox.PollInterval),
+			outbox.WithMaxMessages(config.NotificationOutbox.MaxMessages),
+		)
+		if err != nil {
+			fmt.Println("Failed to initialize notification outbox app: ", err)
+			os.Exit(1)
+		}
 
-	if err := taxicallOutboxApp.Start(ctx); err != nil {
-		fmt.Println("Failed to start taxicall outbox app: ", err)
-		os.Exit(1)
-	}
-	defer taxicallOutboxApp.Shuwdown()
+		taxicallOutboxApp, err := outbox.NewOutboxApp(
+			outbox.WithTransactor(transactor),
+			outbox.WithEventRepository(eventRepository),
+			outbox.WithEventPublishService(taxicallPublisherService),
+			outbox.WithDeadLetterRepository(deadLetterRepository),
+			outbox.WithName("taxicall"),
+			outbox.WithTopic(enum.EventTopic_TAXICALL),
+			outbox.WithTargetEventUirs(config.TaxicallOutbox.EventUris),
+			outbox.WithPollInterval(config.TaxicallOutbox.PollInterval),
+			outbox.WithMaxMessages(config.TaxicallOutbox.MaxMessages),
+		)
+		if err != nil {
+			fmt.Println("Failed to initialize taxicall outbox app: ", err)
+			os.Exit(1)
+		}
 
-	if err := taxicallApp.Start(ctx); err != nil {
-		fmt.Printf("Failed to start taxi call app event loop: %v\n", err)
-		os.Exit(1)
+		lifecycle.Add("Notification Outbox Relay", notificationOutboxApp)
+		lifecycle.Add("Taxi Call Outbox Relay", taxicallOutboxApp)
 	}
 
 	userSessionApp, err := usersession.NewUserSessionApp(
@@ -262,15 +365,45 @@ func main() {
 		user.WithLocationService(locationService),
 		user.WithPushService(pushApp),
 		user.WithTaxiCallService(taxicallApp),
+		user.WithReferralService(referralApp),
+		user.WithCouponService(couponApp),
+		user.WithCorporateService(corporateApp),
 	)
 	if err != nil {
 		fmt.Printf("Failed to setup user app: %v\n", err)
 		os.Exit(1)
 	}
 
+	driverDutyApp, err := driverduty.NewDriverDutyApp(
+		driverduty.WithTransactor(transactor),
+		driverduty.WithDriverDutySessionRepository(driverDutySessionRepository),
+		driverduty.WithDriverRepository(driverRepository),
+		driverduty.WithTaxiCallService(taxicallApp),
+		driverduty.WithTimeout(config.DriverDuty.Timeout),
+		driverduty.WithCheckInterval(config.DriverDuty.CheckInterval),
+	)
+	if err != nil {
+		fmt.Printf("Failed to setup driver duty app: %v\n", err)
+		os.Exit(1)
+	}
+
+	demandApp, err := demand.NewDemandApp(
+		demand.WithTransactor(transactor),
+		demand.WithDemandRepository(demandRepository),
+		demand.WithCellSize(config.DemandHeatmap.CellSize),
+		demand.WithSearchRange(config.DemandHeatmap.SearchRange),
+		demand.WithMinUserCount(config.DemandHeatmap.MinUserCount),
+	)
+	if err != nil {
+		fmt.Printf("Failed to setup demand app: %v\n", err)
+		os.Exit(1)
+	}
+
 	driverApp, err := driver.NewDriverApp(
 		driver.WithTransactor(transactor),
 		driver.WithDriverRepository(driverRepository),
+		driver.WithDriverDocumentRepository(driverDocumentRepository),
+		driver.WithVehicleRepository(vehicleRepository),
 		driver.WithSettlementAccountRepository(driverSettlementAccountRepository),
 		driver.WithSessionService(driverSessionApp),
 		driver.WithSmsSenderService(smsSenderService),
@@ -279,12 +412,26 @@ func main() {
 		driver.WithEventRepository(eventRepository),
 		driver.WithPushService(pushApp),
 		driver.WithTaxiCallService(taxicallApp),
+		driver.WithDutyService(driverDutyApp),
+		driver.WithDemandService(demandApp),
 	)
 	if err != nil {
 		fmt.Printf("Failed to setup driver app: %v\n", err)
 		os.Exit(1)
 	}
 
+	idempotencyApp, err := idempotency.NewIdempotencyApp(
+		idempotency.WithTransactor(transactor),
+		idempotency.WithIdempotencyKeyRepository(idempotencyKeyRepository),
+		idempotency.WithTTL(config.Idempotency.Ttl),
+		idempotency.WithCleanupInterval(config.Idempotency.CleanupInterval),
+		idempotency.WithLease(config.Idempotency.Lease),
+	)
+	if err != nil {
+		fmt.Printf("Failed to setup idempotency app: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Init middlewares
 	userSessionMiddleware := userserver.NewSessionMiddleware(userSessionApp)
 
@@ -292,71 +439,127 @@ func main() {
 
 	backofficeSessionMiddleware := backofficeserver.NewSessionMiddleware(config.Backoffice.Secret)
 
+	userIdempotencyMiddleware := server.NewIdempotencyMiddleware(idempotencyApp, utils.LookupUserId)
+
+	driverIdempotencyMiddleware := server.NewIdempotencyMiddleware(idempotencyApp, utils.LookupDriverId)
+
 	// Init servers
 	userServer, err := userserver.NewUserServer(
 		userserver.WithEndpoint("0.0.0.0"),
 		userserver.WithPort(18881),
 		userserver.WithUserApp(userApp),
+		userserver.WithRealtimeSubscriber(realtimeService),
+		userserver.WithMiddleware(otelecho.Middleware("user")),
+		userserver.WithMiddleware(server.NewMetricMiddleware("user").Process),
 		userserver.WithMiddleware(userSessionMiddleware.Get()),
 		userserver.WithMiddleware(userserver.UserIdChecker),
+		userserver.WithMiddleware(userIdempotencyMiddleware.Process),
 	)
 	if err != nil {
 		fmt.Printf("Failed to setup user server: %v\n", err)
 		os.Exit(1)
 	}
-	defer userServer.Stop(ctx)
 
 	driverServer, err := driverserver.NewDriverServer(
 		driverserver.WithEndpoint("0.0.0.0"),
 		driverserver.WithPort(18882),
 		driverserver.WithDriverApp(driverApp),
+		driverserver.WithRealtimeSubscriber(realtimeService),
+		driverserver.WithMiddleware(otelecho.Middleware("driver")),
+		driverserver.WithMiddleware(server.NewMetricMiddleware("driver").Process),
 		driverserver.WithMiddleware(driverSessionMiddleware.Get()),
 		driverserver.WithMiddleware(driverserver.DriverIdChecker),
+		driverserver.WithMiddleware(driverIdempotencyMiddleware.Process),
 	)
 	if err != nil {
 		fmt.Printf("Failed to setup driver server: %v\n", err)
 		os.Exit(1)
 	}
-	defer driverServer.Stop(ctx)
 
 	backofficeServer, err := backofficeserver.NewBackofficeServer(
 		backofficeserver.WithEndpoint("0.0.0.0"),
 		backofficeserver.WithPort(18883),
 		backofficeserver.WithDriverApp(driverApp),
 		backofficeserver.WithUserApp(userApp),
+		backofficeserver.WithCouponApp(couponApp),
+		backofficeserver.WithReferralApp(referralApp),
+		backofficeserver.WithCorporateApp(corporateApp),
+		backofficeserver.WithTaxiCallApp(taxicallApp),
+		backofficeserver.WithDeadLetterApp(deadLetterApp),
+		backofficeserver.WithMiddleware(otelecho.Middleware("backoffice")),
+		backofficeserver.WithMiddleware(server.NewMetricMiddleware("backoffice").Process),
 		backofficeserver.WithMiddleware(backofficeSessionMiddleware.Get()),
 	)
 	if err != nil {
 		fmt.Printf("Failed to setup backoffice server: %v\n", err)
 		os.Exit(1)
 	}
-	defer backofficeServer.Stop(ctx)
-
-	go func() {
-		if err := userServer.Run(ctx); err != nil && err != http.ErrServerClosed {
-			// TODO (taekyeom) fatal log
-			fmt.Printf("shutting down user server:\n%v", err)
-		}
-	}()
-
-	go func() {
-		if err := driverServer.Run(ctx); err != nil && err != http.ErrServerClosed {
-			// TODO (taekyeom) fatal log
-			fmt.Printf("shutting down driver server:\n%v", err)
-		}
-	}()
 
-	go func() {
-		if err := backofficeServer.Run(ctx); err != nil && err != http.ErrServerClosed {
-			// TODO (taekyeom) fatal log
-			fmt.Printf("shutting down backoffice server:\n%v", err)
-		}
-	}()
+	lifecycle.Add("Taxi Call Push Consumer", pushApp)
+	lifecycle.Add("Taxi Call Consumer", taxicallApp)
+	lifecycle.Add("Driver Duty Session Expirer", driverDutyApp)
+	lifecycle.Add("Idempotency Key Cleaner", idempotencyApp)
+	lifecycle.Add("User API", app.NewServerRunnable("user server", &userServer))
+	lifecycle.Add("Driver API", app.NewServerRunnable("driver server", &driverServer))
+	lifecycle.Add("Backoffice API", app.NewServerRunnable("backoffice server", &backofficeServer))
+	lifecycle.Add("Metric API", app.NewServerRunnable("metric server", server.NewMetricServer(config.Metric.Port)))
+
+	if err := lifecycle.Start(ctx); err != nil {
+		fmt.Printf("Failed to start [Taco-Backend] service: %v\n", err)
+		os.Exit(1)
+	}
 
 	// Use a buffered channel to avoid missing signals as recommended for signal.Notify
 	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, os.Interrupt)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 	<-quit
 	fmt.Println("shutting down [Taco-Backend] service... because of interrupt")
+
+	// In-flight events are drained before cancelling context
+	if err := lifecycle.Stop(); err != nil {
+		fmt.Printf("Failed to stop [Taco-Backend] service gracefully: %v\n", err)
+	}
+
+	// Spans of drained works are flushed after runnables are stopped
+	if err := shutdownTracing(ctx); err != nil {
+		fmt.Printf("Failed to flush traces: %v\n", err)
+	}
 	cancel()
 }
+
+func newEventPublisher(ctx context.Context, eventBusConfig config.EventBusConfig, db *bun.DB,
+	topicConfig config.TopicConfig) (service.EventPublishService, func(), error) {
+	switch eventBusConfig.Type {
+	case config.EventBusType_SQS:
+		publisher, err := pubsub.OpenTopic(ctx, topicConfig.GetSqsUri())
+		if err != nil {
+			return nil, nil, err
+		}
+		return service.NewSqsPubService(publisher), func() { publisher.Shutdown(ctx) }, nil
+	case config.EventBusType_POSTGRES:
+		return service.NewPostgresPubService(db, topicConfig.Uri), func() {}, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown event bus type: %s", eventBusConfig.Type)
+	}
+}
+
+func newEventSubscriber(ctx context.Context, eventBusConfig config.EventBusConfig, db *bun.DB,
+	topicConfig config.TopicConfig) (service.EventSubscriptionService, func(), error) {
+	switch eventBusConfig.Type {
+	case config.EventBusType_SQS:
+		subscriber, err := pubsub.OpenSubscription(ctx, topicConfig.GetSqsUri())
+		if err != nil {
+			return nil, nil, err
+		}
+		return service.NewSqsSubService(subscriber), func() { subscriber.Shutdown(ctx) }, nil
+	case config.EventBusType_POSTGRES:
+		subscriber, err := service.NewPostgresSubService(ctx, db, topicConfig.Uri,
+			eventBusConfig.VisibilityTimeout, eventBusConfig.PollInterval)
+		if err != nil {
+			return nil, nil, err
+		}
+		return subscriber, func() { subscriber.Close() }, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown event bus type: %s", eventBusConfig.Type)
+	}
+}
@@ -6,7 +6,9 @@ import (
 )
 
 type LogConfig struct {
-	Query bool `env:"TACO_ENABLE_QUERY_DEBUG_LOG,default=true"`
+	Query  bool   `env:"TACO_ENABLE_QUERY_DEBUG_LOG,default=true"`
+	Level  string `env:"TACO_LOG_LEVEL,default=info"`  // debug, info, warn, error
+	Format string `env:"TACO_LOG_FORMAT,default=json"` // json, console
 }
 
 type DatabaseConfig struct {
@@ -45,10 +47,55 @@ type BackofficeConfig struct {
 	Secret string `env:"TACO_BACKOFFICE_SECRET,required"`
 }
 
+type FileUploadConfig struct {
+	BucketUri      string        `env:"TACO_FILE_UPLOAD_BUCKET_URI,required"` // eg. s3://bucket?region=ap-northeast-2
+	DownloadExpire time.Duration `env:"TACO_FILE_UPLOAD_DOWNLOAD_EXPIRE,default=10m"`
+}
+
+type DriverDutyConfig struct {
+	Timeout       time.Duration `env:"TACO_DRIVER_DUTY_TIMEOUT,default=30m"`
+	CheckInterval time.Duration `env:"TACO_DRIVER_DUTY_CHECK_INTERVAL,default=1m"`
+}
+
+type DemandHeatmapConfig struct {
+	CellSize     float64 `env:"TACO_DEMAND_HEATMAP_CELL_SIZE,default=0.005"`   // About 500m
+	SearchRange  float64 `env:"TACO_DEMAND_HEATMAP_SEARCH_RANGE,default=0.05"` // About 5km
+	MinUserCount int     `env:"TACO_DEMAND_HEATMAP_MIN_USER_COUNT,default=3"`
+}
+
+type IdempotencyConfig struct {
+	Ttl             time.Duration `env:"TACO_IDEMPOTENCY_TTL,default=24h"`
+	CleanupInterval time.Duration `env:"TACO_IDEMPOTENCY_CLEANUP_INTERVAL,default=10m"`
+	Lease           time.Duration `env:"TACO_IDEMPOTENCY_LEASE,default=1m"` // Must be longer than request timeout
+}
+
+type RealtimeConfig struct {
+	BufferSize int `env:"TACO_REALTIME_BUFFER_SIZE,default=16"` // Pending notifications per connection
+}
+
+type PushWorkerConfig struct {
+	Concurrency int `env:"TACO_PUSH_WORKER_CONCURRENCY,default=8"`
+}
+
+type NotificationTemplateConfig struct {
+	Path string `env:"TACO_NOTIFICATION_TEMPLATE_PATH"` // Use embedded default templates if empty
+}
+
 type FirebaseConfig struct {
 	DryRun bool `env:"TACO_FIREBASE_DRY_RUN,default=true"`
 }
 
+const (
+	EventBusType_SQS      = "sqs"
+	EventBusType_POSTGRES = "postgres" // For local development without aws, topic uri is used as topic name
+)
+
+type EventBusConfig struct {
+	Type              string        `env:"TACO_EVENT_BUS_TYPE,default=sqs"`
+	VisibilityTimeout time.Duration `env:"TACO_EVENT_BUS_VISIBILITY_TIMEOUT,default=30s"` // Only for postgres
+	PollInterval      time.Duration `env:"TACO_EVENT_BUS_POLL_INTERVAL,default=1s"`       // Only for postgres
+}
+
 type EventOutboxConfig struct {
 	EventUris    []string      `env:"EVENT_URIS,required"`
 	PollInterval time.Duration `env:"POLL_INTERVAL,required"`
@@ -62,3 +109,22 @@ type TopicConfig struct {
 func (e TopicConfig) GetSqsUri() string {
 	return fmt.Sprintf("awssqs://%s?awssdk=v2", e.Uri)
 }
+
+type OutboxRelayConfig struct {
+	Embedded   bool `env:"TACO_OUTBOX_EMBEDDED,default=true"` // Run outbox relays in api server, disable if standalone outbox relay is deployed
+	HealthPort int  `env:"TACO_OUTBOX_HEALTH_PORT,default=18884"`
+}
+
+type TracingConfig struct {
+	Enabled     bool    `env:"TACO_TRACING_ENABLED,default=false"`
+	Endpoint    string  `env:"TACO_TRACING_ENDPOINT,default=localhost:4318"` // OTLP http endpoint of the collector
+	SampleRatio float64 `env:"TACO_TRACING_SAMPLE_RATIO,default=0.1"`
+}
+
+type MetricConfig struct {
+	Port int `env:"TACO_METRICS_PORT,default=18885"`
+}
+
+type LifecycleConfig struct {
+	StopTimeout time.Duration `env:"TACO_STOP_TIMEOUT,default=20s"` // Max duration to drain in-flight works on shutdown
+}
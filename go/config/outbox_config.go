@@ -0,0 +1,28 @@
+package config
+
+import (
+	"context"
+
+	"github.com/sethvargo/go-envconfig"
+)
+
+type OutboxConfig struct {
+	Log                LogConfig
+	Database           DatabaseConfig
+	EventBus           EventBusConfig
+	OutboxRelay        OutboxRelayConfig
+	Lifecycle          LifecycleConfig
+	Tracing            TracingConfig
+	NotificationTopic  TopicConfig       `env:",prefix=TACO_NOTIFICATION_"`
+	TaxicallTopic      TopicConfig       `env:",prefix=TACO_TAXICALL_"`
+	NotificationOutbox EventOutboxConfig `env:",prefix=TACO_NOTIFICATION_OUTBOX_"`
+	TaxicallOutbox     EventOutboxConfig `env:",prefix=TACO_TAXICALL_OUTBOX_"`
+}
+
+func NewOutboxConfig(ctx context.Context) (OutboxConfig, error) {
+	config := OutboxConfig{}
+
+	err := envconfig.Process(ctx, &config)
+
+	return config, err
+}
@@ -7,18 +7,30 @@ import (
 )
 
 type ServerConfig struct {
-	Log                LogConfig
-	Database           DatabaseConfig
-	SmsSender          SmsSenderConfig
-	PaymentService     PaymentServiceConfig
-	RouteService       RouteServiceConfig
-	LocationService    LocationServiceConfig
-	Backoffice         BackofficeConfig
-	Firebase           FirebaseConfig
-	NotificationTopic  TopicConfig       `env:",prefix=TACO_NOTIFICATION_"`
-	TaxicallTopic      TopicConfig       `env:",prefix=TACO_TAXICALL_"`
-	NotificationOutbox EventOutboxConfig `env:",prefix=TACO_NOTIFICATION_OUTBOX_"`
-	TaxicallOutbox     EventOutboxConfig `env:",prefix=TACO_TAXICALL_OUTBOX_"`
+	Log                  LogConfig
+	Database             DatabaseConfig
+	SmsSender            SmsSenderConfig
+	PaymentService       PaymentServiceConfig
+	RouteService         RouteServiceConfig
+	LocationService      LocationServiceConfig
+	Backoffice           BackofficeConfig
+	Firebase             FirebaseConfig
+	FileUpload           FileUploadConfig
+	DriverDuty           DriverDutyConfig
+	DemandHeatmap        DemandHeatmapConfig
+	Idempotency          IdempotencyConfig
+	Realtime             RealtimeConfig
+	PushWorker           PushWorkerConfig
+	NotificationTemplate NotificationTemplateConfig
+	EventBus             EventBusConfig
+	OutboxRelay          OutboxRelayConfig
+	Lifecycle            LifecycleConfig
+	Metric               MetricConfig
+	Tracing              TracingConfig
+	NotificationTopic    TopicConfig       `env:",prefix=TACO_NOTIFICATION_"`
+	TaxicallTopic        TopicConfig       `env:",prefix=TACO_TAXICALL_"`
+	NotificationOutbox   EventOutboxConfig `env:",prefix=TACO_NOTIFICATION_OUTBOX_"`
+	TaxicallOutbox       EventOutboxConfig `env:",prefix=TACO_TAXICALL_OUTBOX_"`
 }
 
 func NewServerConfig(ctx context.Context) (ServerConfig, error) {
@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/uptrace/bun"
+)
+
+var (
+	// Policy time window is evaluated in korea standard time
+	corporatePolicyLocation = time.FixedZone("KST", 9*60*60)
+)
+
+type Corporate struct {
+	bun.BaseModel `bun:"table:corporate"`
+
+	Id           string    `bun:"id,pk"`
+	Name         string    `bun:"name"`
+	BillingEmail string    `bun:"billing_email"`
+	CreateTime   time.Time `bun:"create_time"`
+	UpdateTime   time.Time `bun:"update_time"`
+}
+
+type CorporateMember struct {
+	bun.BaseModel `bun:"table:corporate_member"`
+
+	CorporateId string    `bun:"corporate_id,pk"`
+	UserId      string    `bun:"user_id,pk"`
+	CreateTime  time.Time `bun:"create_time"`
+}
+
+type CorporatePolicy struct {
+	bun.BaseModel `bun:"table:corporate_policy"`
+
+	CorporateId     string    `bun:"corporate_id,pk"`
+	StartHour       int       `bun:"start_hour"`         // inclusive, 0 ~ 23
+	EndHour         int       `bun:"end_hour"`           // exclusive, 1 ~ 24. Start hour > end hour means overnight window
+	Regions         []string  `bun:"regions,array"`      // eg. "서울", "서울 강남구". Empty means every region
+	MaxPricePerRide int       `bun:"max_price_per_ride"` // 0 means no limit
+	UpdateTime      time.Time `bun:"update_time"`
+}
+
+func NewDefaultCorporatePolicy(corporateId string, t time.Time) CorporatePolicy {
+	return CorporatePolicy{
+		CorporateId:     corporateId,
+		StartHour:       0,
+		EndHour:         24,
+		Regions:         []string{},
+		MaxPricePerRide: 0,
+		UpdateTime:      t,
+	}
+}
+
+func (c CorporatePolicy) AvailableTime(t time.Time) bool {
+	hour := t.In(corporatePolicyLocation).Hour()
+
+	if c.StartHour <= c.EndHour {
+		return c.StartHour <= hour && hour < c.EndHour
+	}
+
+	return c.StartHour <= hour || hour < c.EndHour
+}
+
+func (c CorporatePolicy) AvailableRegion(addresses ...value.Address) bool {
+	if len(c.Regions) == 0 {
+		return true
+	}
+
+	for _, address := range addresses {
+		if !address.InRegions(c.Regions) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c CorporatePolicy) AvailablePrice(price int) bool {
+	return c.MaxPricePerRide == 0 || price <= c.MaxPricePerRide
+}
+
+type CorporateInvoice struct {
+	bun.BaseModel `bun:"table:corporate_invoice"`
+
+	Id           string    `bun:"id,pk"`
+	CorporateId  string    `bun:"corporate_id"`
+	BillingMonth string    `bun:"billing_month"` // eg. 2022-10
+	RideCount    int       `bun:"ride_count"`
+	TotalPrice   int       `bun:"total_price"`
+	CreateTime   time.Time `bun:"create_time"`
+}
+
+// CorporateBillingPeriod returns [start, end) of the billing month in korea standard time (as UTC)
+func CorporateBillingPeriod(billingMonth string) (time.Time, time.Time, error) {
+	start, err := time.ParseInLocation("2006-01", billingMonth, corporatePolicyLocation)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: invalid billing month %s", value.ErrInvalidOperation, billingMonth)
+	}
+
+	return start.UTC(), start.AddDate(0, 1, 0).UTC(), nil
+}
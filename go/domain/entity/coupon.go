@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/uptrace/bun"
+)
+
+type Coupon struct {
+	bun.BaseModel `bun:"table:coupon"`
+
+	Id               string                  `bun:"id,pk"`
+	Code             string                  `bun:"code"`
+	Name             string                  `bun:"name"`
+	DiscountType     enum.CouponDiscountType `bun:"discount_type"`
+	DiscountAmount   int                     `bun:"discount_amount"`    // FIXED: 할인 금액, PERCENTAGE: 할인율 (%)
+	MaxDiscountPrice int                     `bun:"max_discount_price"` // 0 means no limit
+	UsageLimit       int                     `bun:"usage_limit"`        // 0 means no limit
+	UsageCount       int                     `bun:"usage_count"`
+	PerUserLimit     int                     `bun:"per_user_limit"` // 0 means no limit
+	UserId           sql.NullString          `bun:"user_id"`        // If set, only the user can use the coupon
+	Regions          []string                `bun:"regions,array"`  // eg. "서울", "서울 강남구". Empty means every region
+	StartTime        time.Time               `bun:"start_time"`
+	ExpireTime       time.Time               `bun:"expire_time"`
+	CreateTime       time.Time               `bun:"create_time"`
+	UpdateTime       time.Time               `bun:"update_time"`
+}
+
+func (c Coupon) Available(t time.Time) bool {
+	if t.Before(c.StartTime) || !t.Before(c.ExpireTime) {
+		return false
+	}
+
+	return c.UsageLimit == 0 || c.UsageCount < c.UsageLimit
+}
+
+func (c Coupon) EligibleUser(userId string) bool {
+	return !c.UserId.Valid || c.UserId.String == userId
+}
+
+func (c Coupon) AvailableRegion(addresses ...value.Address) bool {
+	if len(c.Regions) == 0 {
+		return true
+	}
+
+	for _, address := range addresses {
+		if !address.InRegions(c.Regions) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c Coupon) GetDiscountPrice(price int) int {
+	var discountPrice int
+
+	switch c.DiscountType {
+	case enum.CouponDiscountType_FIXED:
+		discountPrice = c.DiscountAmount
+	case enum.CouponDiscountType_PERCENTAGE:
+		discountPrice = price * c.DiscountAmount / 100
+	}
+
+	if c.MaxDiscountPrice > 0 && discountPrice > c.MaxDiscountPrice {
+		discountPrice = c.MaxDiscountPrice
+	}
+
+	if discountPrice > price {
+		discountPrice = price
+	}
+
+	return discountPrice
+}
+
+type CouponUsage struct {
+	bun.BaseModel `bun:"table:coupon_usage"`
+
+	TaxiCallRequestId string                `bun:"taxi_call_request_id,pk"`
+	CouponId          string                `bun:"coupon_id"`
+	UserId            string                `bun:"user_id"`
+	State             enum.CouponUsageState `bun:"coupon_usage_state"`
+	DiscountPrice     int                   `bun:"discount_price"`
+	CreateTime        time.Time             `bun:"create_time"`
+	UpdateTime        time.Time             `bun:"update_time"`
+}
@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/taco-labs/taco/go/utils"
+	"github.com/uptrace/bun"
+)
+
+// DeadLetter is an event which is failed to be handled until retry count is exhausted
+type DeadLetter struct {
+	bun.BaseModel `bun:"table:dead_letter"`
+
+	Id               string          `bun:"id,pk"`
+	Topic            enum.EventTopic `bun:"topic"`
+	EventUri         string          `bun:"event_uri"`
+	Payload          json.RawMessage `bun:"payload,type:jsonb"`
+	RetryCount       int             `bun:"retry_count"`
+	LastError        string          `bun:"last_error"`
+	FirstAttemptTime time.Time       `bun:"first_attempt_time"`
+	LastAttemptTime  time.Time       `bun:"last_attempt_time"`
+	CreateTime       time.Time       `bun:"create_time"`
+
+	// Retry policy & trace context of the event, restored on replay
+	MaxRetryCount int    `bun:"max_retry_count"`
+	TraceParent   string `bun:"trace_parent,nullzero"`
+	TraceState    string `bun:"trace_state,nullzero"`
+}
+
+func NewDeadLetter(topic enum.EventTopic, event Event, cause error, t time.Time) DeadLetter {
+	return DeadLetter{
+		Id:               utils.MustNewUUID(),
+		Topic:            topic,
+		EventUri:         event.EventUri,
+		Payload:          event.Payload,
+		RetryCount:       event.RetryCount,
+		LastError:        cause.Error(),
+		FirstAttemptTime: event.GetFirstAttemptTime(),
+		LastAttemptTime:  t,
+		CreateTime:       t,
+		MaxRetryCount:    event.MaxRetryCount,
+		TraceParent:      event.TraceParent,
+		TraceState:       event.TraceState,
+	}
+}
+
+// ToEvent returns new event to replay with reset retry count, which continues the trace of the original event
+func (d DeadLetter) ToEvent() Event {
+	return Event{
+		MessageId:     utils.MustNewUUID(),
+		EventUri:      d.EventUri,
+		DelaySeconds:  0,
+		Payload:       d.Payload,
+		CreateTime:    time.Now().UTC(),
+		RetryCount:    0,
+		MaxRetryCount: d.MaxRetryCount,
+		TraceParent:   d.TraceParent,
+		TraceState:    d.TraceState,
+	}
+}
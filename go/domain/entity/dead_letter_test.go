@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/value/enum"
+)
+
+func TestDeadLetter_ToEvent(t *testing.T) {
+	event := Event{
+		MessageId:     "message",
+		EventUri:      "uri",
+		Payload:       []byte("{}"),
+		RetryCount:    5,
+		MaxRetryCount: 5,
+		TraceParent:   "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		TraceState:    "vendor=value",
+	}
+
+	replayed := NewDeadLetter(enum.EventTopic_TAXICALL, event, errors.New("failed"), time.Now()).ToEvent()
+
+	if replayed.MessageId == event.MessageId || replayed.RetryCount != 0 {
+		t.Errorf("expected new event with reset retry count, got %+v", replayed)
+	}
+	if replayed.MaxRetryCount != event.MaxRetryCount {
+		t.Errorf("expected max retry count %d, got %d", event.MaxRetryCount, replayed.MaxRetryCount)
+	}
+	if replayed.TraceParent != event.TraceParent || replayed.TraceState != event.TraceState {
+		t.Errorf("expected trace context to be restored, got %q, %q", replayed.TraceParent, replayed.TraceState)
+	}
+}
@@ -21,6 +21,7 @@ type Driver struct {
 	AppOs                 enum.OsType     `bun:"app_os"`
 	AppVersion            string          `bun:"app_version"`
 	AppFcmToken           string          `bun:"app_fcm_token"`
+	Language              enum.Language   `bun:"language"`
 	UserUniqueKey         string          `bun:"user_unique_key"`
 	DriverLicenseId       string          `bun:"driver_license_id"`
 	DriverLicenseImageUrl string          `bun:"driver_license_image_url"`
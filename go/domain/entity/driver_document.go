@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/uptrace/bun"
+)
+
+// RequiredDriverDocumentTypes are document types which must be approved before driver activation
+var RequiredDriverDocumentTypes = []enum.DriverDocumentType{
+	enum.DriverDocumentType_DRIVER_LICENSE,
+	enum.DriverDocumentType_VEHICLE_REGISTRATION,
+	enum.DriverDocumentType_INSURANCE,
+}
+
+type DriverDocument struct {
+	bun.BaseModel `bun:"table:driver_document"`
+
+	DriverId     string                   `bun:"driver_id,pk"`
+	DocumentType enum.DriverDocumentType  `bun:"document_type,pk"`
+	FileKey      string                   `bun:"file_key"`
+	State        enum.DriverDocumentState `bun:"driver_document_state"`
+	RejectReason string                   `bun:"reject_reason"`
+	ExpireTime   time.Time                `bun:"expire_time"` // Set by backoffice on approval
+	ReviewTime   time.Time                `bun:"review_time"`
+	CreateTime   time.Time                `bun:"create_time"`
+	UpdateTime   time.Time                `bun:"update_time"`
+}
+
+func (d DriverDocument) Valid(t time.Time) bool {
+	return d.State == enum.DriverDocumentState_APPROVED && t.Before(d.ExpireTime)
+}
+
+type DriverDocuments []DriverDocument
+
+// Verified returns whether every required document is approved and not expired
+func (d DriverDocuments) Verified(t time.Time) bool {
+	for _, documentType := range RequiredDriverDocumentTypes {
+		verified := false
+		for _, document := range d {
+			if document.DocumentType == documentType && document.Valid(t) {
+				verified = true
+				break
+			}
+		}
+		if !verified {
+			return false
+		}
+	}
+
+	return true
+}
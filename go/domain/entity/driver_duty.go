@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/uptrace/bun"
+)
+
+type DriverDutySession struct {
+	bun.BaseModel `bun:"table:driver_duty_session"`
+
+	Id             string                   `bun:"id,pk"`
+	DriverId       string                   `bun:"driver_id"`
+	StartTime      time.Time                `bun:"start_time"`
+	LastActiveTime time.Time                `bun:"last_active_time"`
+	EndTime        time.Time                `bun:"end_time,nullzero"`   // Null while driver is on duty
+	EndReason      enum.DriverDutyEndReason `bun:"end_reason,nullzero"` // Null while driver is on duty
+}
+
+func (d DriverDutySession) Active() bool {
+	return d.EndTime.IsZero()
+}
+
+// OnlineDuration returns online duration of the session clipped by [from, to)
+func (d DriverDutySession) OnlineDuration(from time.Time, to time.Time) time.Duration {
+	start := d.StartTime
+	if start.Before(from) {
+		start = from
+	}
+
+	end := d.EndTime
+	if d.Active() || end.After(to) {
+		end = to
+	}
+
+	if !end.After(start) {
+		return 0
+	}
+
+	return end.Sub(start)
+}
+
+type DriverDutyStats struct {
+	DriverId       string
+	From           time.Time
+	To             time.Time
+	SessionCount   int
+	RideCount      int
+	OnlineDuration time.Duration
+	RideDuration   time.Duration
+	IdleDuration   time.Duration
+	Utilization    float64 // Ratio of ride duration to online duration
+}
+
+func NewDriverDutyStats(driverId string, from time.Time, to time.Time,
+	sessions []DriverDutySession, rideCount int, rideDuration time.Duration) DriverDutyStats {
+	var onlineDuration time.Duration
+	for _, session := range sessions {
+		onlineDuration += session.OnlineDuration(from, to)
+	}
+
+	// Rides can be slightly longer than online duration as ride duration counts from the call request
+	if rideDuration > onlineDuration {
+		rideDuration = onlineDuration
+	}
+
+	var utilization float64
+	if onlineDuration > 0 {
+		utilization = float64(rideDuration) / float64(onlineDuration)
+	}
+
+	return DriverDutyStats{
+		DriverId:       driverId,
+		From:           from,
+		To:             to,
+		SessionCount:   len(sessions),
+		RideCount:      rideCount,
+		OnlineDuration: onlineDuration,
+		RideDuration:   rideDuration,
+		IdleDuration:   onlineDuration - rideDuration,
+		Utilization:    utilization,
+	}
+}
@@ -12,9 +12,16 @@ import (
 )
 
 const (
-	MetadataKey_RetryCount = "retry_count"
-	MetaDataKey_EventUri   = "event_uri"
-	MetaDataKey_MessageId  = "message_id"
+	MetadataKey_RetryCount    = "retry_count"
+	MetadataKey_MaxRetryCount = "max_retry_count"
+	MetaDataKey_EventUri      = "event_uri"
+	MetaDataKey_MessageId     = "message_id"
+
+	MetadataKey_FirstAttemptTime = "first_attempt_time"
+
+	// W3C trace context keys
+	MetadataKey_TraceParent = "traceparent"
+	MetadataKey_TraceState  = "tracestate"
 )
 
 type Event struct {
@@ -26,7 +33,20 @@ type Event struct {
 	Payload      json.RawMessage `bun:"payload,type:jsonb"`
 	CreateTime   time.Time       `bun:"create_time"`
 	RetryCount   int             `bun:"-"`
-	ackFn        func() error
+
+	// Trace context of the span which created the event, so that consumers continue the trace
+	TraceParent string `bun:"trace_parent,nullzero"`
+	TraceState  string `bun:"trace_state,nullzero"`
+
+	// Count of failed publish by outbox relay
+	PublishFailureCount int `bun:"publish_failure_count"`
+
+	// Max retry count resolved from retry policy on first retry, zero if not retried yet
+	MaxRetryCount int `bun:"-"`
+
+	// Create time of the first event, preserved on retry
+	FirstAttemptTime time.Time `bun:"-"`
+	ackFn            func() error
 }
 
 func (e Event) NewEventWithRetry() Event {
@@ -37,7 +57,20 @@ func (e Event) NewEventWithRetry() Event {
 		Payload:      e.Payload,
 		CreateTime:   time.Now().UTC(),
 		RetryCount:   e.RetryCount + 1,
+
+		MaxRetryCount:    e.MaxRetryCount,
+		FirstAttemptTime: e.GetFirstAttemptTime(),
+
+		TraceParent: e.TraceParent,
+		TraceState:  e.TraceState,
+	}
+}
+
+func (e Event) GetFirstAttemptTime() time.Time {
+	if e.FirstAttemptTime.IsZero() {
+		return e.CreateTime
 	}
+	return e.FirstAttemptTime
 }
 
 func (u *Event) BeforeAppendModel(ctx context.Context, query bun.Query) error {
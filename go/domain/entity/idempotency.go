@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type IdempotencyKey struct {
+	bun.BaseModel `bun:"table:idempotency_key"`
+
+	PrincipalId    string    `bun:"principal_id,pk"`
+	Key            string    `bun:"idempotency_key,pk"`
+	Route          string    `bun:"route"`        // eg. "POST /taxicall"
+	RequestHash    string    `bun:"request_hash"` // Hex encoded sha256 of request body
+	ResponseStatus int       `bun:"response_status"`
+	ResponseBody   []byte    `bun:"response_body"`
+	CreateTime     time.Time `bun:"create_time"`
+	ExpireTime     time.Time `bun:"expire_time"`
+
+	// In progress key whose lease is expired is considered abandoned (eg. server crashed before completion),
+	// so that retried request can take it over
+	LeaseExpireTime time.Time `bun:"lease_expire_time"`
+}
+
+// Completed returns false while the first request with the key is still in progress
+func (i IdempotencyKey) Completed() bool {
+	return i.ResponseStatus != 0
+}
+
+// Abandoned returns whether the request with the key is not completed within its lease
+func (i IdempotencyKey) Abandoned(t time.Time) bool {
+	return !i.Completed() && !t.Before(i.LeaseExpireTime)
+}
+
+func (i IdempotencyKey) Expired(t time.Time) bool {
+	return !t.Before(i.ExpireTime)
+}
+
+// Match returns whether the request is a retry of the stored one
+func (i IdempotencyKey) Match(other IdempotencyKey) bool {
+	return i.Route == other.Route && i.RequestHash == other.RequestHash
+}
@@ -0,0 +1,94 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/uptrace/bun"
+)
+
+var (
+	// Quiet hours are evaluated in korea standard time
+	quietHoursLocation = time.FixedZone("KST", 9*60*60)
+)
+
+type NotificationPreference struct {
+	bun.BaseModel `bun:"table:notification_preference"`
+
+	PrincipalId        string                   `bun:"principal_id,pk"`
+	ProgressEnabled    bool                     `bun:"progress_enabled"`
+	ProgressChannel    enum.NotificationChannel `bun:"progress_channel"`
+	MarketingAgreed    bool                     `bun:"marketing_agreed"`
+	MarketingAgreeTime time.Time                `bun:"marketing_agree_time,nullzero"`
+	MarketingChannel   enum.NotificationChannel `bun:"marketing_channel"`
+	QuietHoursEnabled  bool                     `bun:"quiet_hours_enabled"`
+	QuietStartHour     int                      `bun:"quiet_start_hour"` // inclusive, 0 ~ 23
+	QuietEndHour       int                      `bun:"quiet_end_hour"`   // exclusive, 0 ~ 24. Start hour > end hour means overnight
+	UpdateTime         time.Time                `bun:"update_time"`
+}
+
+func NewDefaultNotificationPreference(principalId string, t time.Time) NotificationPreference {
+	return NotificationPreference{
+		PrincipalId:       principalId,
+		ProgressEnabled:   true,
+		ProgressChannel:   enum.NotificationChannel_PUSH,
+		MarketingAgreed:   false,
+		MarketingChannel:  enum.NotificationChannel_PUSH,
+		QuietHoursEnabled: false,
+		QuietStartHour:    22,
+		QuietEndHour:      8,
+		UpdateTime:        t,
+	}
+}
+
+func (n NotificationPreference) InQuietHours(t time.Time) bool {
+	if !n.QuietHoursEnabled {
+		return false
+	}
+
+	hour := t.In(quietHoursLocation).Hour()
+
+	if n.QuietStartHour <= n.QuietEndHour {
+		return n.QuietStartHour <= hour && hour < n.QuietEndHour
+	}
+
+	return n.QuietStartHour <= hour || hour < n.QuietEndHour
+}
+
+// Allow returns whether notification of the category can be sent at the time.
+// Transactional notifications are always allowed.
+func (n NotificationPreference) Allow(category enum.NotificationCategory, t time.Time) bool {
+	switch category {
+	case enum.NotificationCategory_TRANSACTIONAL:
+		return true
+	case enum.NotificationCategory_PROGRESS:
+		return n.ProgressEnabled && !n.InQuietHours(t)
+	case enum.NotificationCategory_MARKETING:
+		return n.MarketingAgreed && !n.InQuietHours(t)
+	default:
+		return false
+	}
+}
+
+// Channel returns channel of the category chosen by the principal.
+// Transactional notifications are sent through push, and fall back to sms regardless of the preference.
+func (n NotificationPreference) Channel(category enum.NotificationCategory) enum.NotificationChannel {
+	switch category {
+	case enum.NotificationCategory_PROGRESS:
+		return enum.NotificationChannelFromString(string(n.ProgressChannel))
+	case enum.NotificationCategory_MARKETING:
+		return enum.NotificationChannelFromString(string(n.MarketingChannel))
+	default:
+		return enum.NotificationChannel_PUSH
+	}
+}
+
+// MarketingConsent is append only record of marketing opt-in & opt-out
+type MarketingConsent struct {
+	bun.BaseModel `bun:"table:marketing_consent"`
+
+	Id          string    `bun:"id,pk"`
+	PrincipalId string    `bun:"principal_id"`
+	Agreed      bool      `bun:"agreed"`
+	CreateTime  time.Time `bun:"create_time"`
+}
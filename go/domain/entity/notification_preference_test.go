@@ -0,0 +1,116 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/value/enum"
+)
+
+func kstTime(hour int) time.Time {
+	return time.Date(2022, 11, 1, hour, 30, 0, 0, quietHoursLocation)
+}
+
+func TestNotificationPreference_InQuietHours(t *testing.T) {
+	testCases := []struct {
+		name      string
+		enabled   bool
+		startHour int
+		endHour   int
+		hour      int
+		expected  bool
+	}{
+		{"disabled", false, 22, 8, 23, false},
+		{"overnight, before start", true, 22, 8, 21, false},
+		{"overnight, at start", true, 22, 8, 22, true},
+		{"overnight, after midnight", true, 22, 8, 3, true},
+		{"overnight, at end", true, 22, 8, 8, false},
+		{"same day, inside", true, 13, 15, 14, true},
+		{"same day, at end", true, 13, 15, 15, false},
+		{"same day, before start", true, 13, 15, 12, false},
+		{"until midnight", true, 20, 24, 23, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			preference := NotificationPreference{
+				QuietHoursEnabled: tc.enabled,
+				QuietStartHour:    tc.startHour,
+				QuietEndHour:      tc.endHour,
+			}
+			if actual := preference.InQuietHours(kstTime(tc.hour)); actual != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestNotificationPreference_InQuietHoursEvaluatedInKST(t *testing.T) {
+	preference := NotificationPreference{
+		QuietHoursEnabled: true,
+		QuietStartHour:    22,
+		QuietEndHour:      8,
+	}
+
+	// 14:00 UTC is 23:00 KST
+	if !preference.InQuietHours(time.Date(2022, 11, 1, 14, 0, 0, 0, time.UTC)) {
+		t.Error("expected to be in quiet hours")
+	}
+}
+
+func TestNotificationPreference_Allow(t *testing.T) {
+	preference := NewDefaultNotificationPreference("principal", time.Time{})
+	preference.ProgressEnabled = false
+	preference.QuietHoursEnabled = true
+
+	testCases := []struct {
+		name            string
+		progressEnabled bool
+		marketingAgreed bool
+		category        enum.NotificationCategory
+		hour            int
+		expected        bool
+	}{
+		{"transactional in quiet hours", false, false, enum.NotificationCategory_TRANSACTIONAL, 23, true},
+		{"progress disabled", false, false, enum.NotificationCategory_PROGRESS, 12, false},
+		{"progress enabled", true, false, enum.NotificationCategory_PROGRESS, 12, true},
+		{"progress in quiet hours", true, false, enum.NotificationCategory_PROGRESS, 23, false},
+		{"marketing not agreed", false, false, enum.NotificationCategory_MARKETING, 12, false},
+		{"marketing agreed", false, true, enum.NotificationCategory_MARKETING, 12, true},
+		{"marketing in quiet hours", false, true, enum.NotificationCategory_MARKETING, 23, false},
+		{"unknown category", true, true, enum.NotificationCategory("UNKNOWN"), 12, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := preference
+			p.ProgressEnabled = tc.progressEnabled
+			p.MarketingAgreed = tc.marketingAgreed
+			if actual := p.Allow(tc.category, kstTime(tc.hour)); actual != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestNotificationPreference_Channel(t *testing.T) {
+	preference := NotificationPreference{
+		ProgressChannel:  enum.NotificationChannel_SMS,
+		MarketingChannel: enum.NotificationChannel_SMS,
+	}
+
+	if actual := preference.Channel(enum.NotificationCategory_TRANSACTIONAL); actual != enum.NotificationChannel_PUSH {
+		t.Errorf("transactional notification must be sent through push, got %v", actual)
+	}
+	if actual := preference.Channel(enum.NotificationCategory_PROGRESS); actual != enum.NotificationChannel_SMS {
+		t.Errorf("expected progress channel sms, got %v", actual)
+	}
+	if actual := preference.Channel(enum.NotificationCategory_MARKETING); actual != enum.NotificationChannel_SMS {
+		t.Errorf("expected marketing channel sms, got %v", actual)
+	}
+
+	// Preference stored before channel was introduced
+	if actual := (NotificationPreference{}).Channel(enum.NotificationCategory_PROGRESS); actual != enum.NotificationChannel_PUSH {
+		t.Errorf("expected default channel push, got %v", actual)
+	}
+}
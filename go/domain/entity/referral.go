@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/uptrace/bun"
+)
+
+var (
+	referralCodes = strings.Split("ABCDEFGHJKLMNPQRSTUVWXYZ23456789", "")
+)
+
+const (
+	ReferralCodeLength = 8
+)
+
+type UserReferral struct {
+	bun.BaseModel `bun:"table:user_referral"`
+
+	RefereeUserId  string             `bun:"referee_user_id,pk"`
+	ReferrerUserId string             `bun:"referrer_user_id"`
+	State          enum.ReferralState `bun:"referral_state"`
+	RejectReason   string             `bun:"reject_reason"`
+	CreateTime     time.Time          `bun:"create_time"`
+	UpdateTime     time.Time          `bun:"update_time"`
+}
+
+type ReferralStats struct {
+	Total    int
+	Pending  int
+	Rewarded int
+	Rejected int
+}
+
+func NewReferralCode() string {
+	b := make([]string, ReferralCodeLength)
+	for i := range b {
+		b[i] = referralCodes[rand.Intn(len(referralCodes))]
+	}
+	return strings.Join(b, "")
+}
@@ -20,8 +20,9 @@ type TaxiCallRequest struct {
 	bun.BaseModel `bun:"table:taxi_call_request"`
 
 	// In Memroy
-	Dryrun bool        `bun:"-"`
-	Route  value.Route `bun:"-"`
+	Dryrun  bool        `bun:"-"`
+	Route   value.Route `bun:"-"`
+	Vehicle Vehicle     `bun:"-"` // Vehicle of assigned driver
 
 	Id                        string               `bun:"id,pk"`
 	UserId                    string               `bun:"user_id"`
@@ -29,12 +30,15 @@ type TaxiCallRequest struct {
 	Departure                 value.Location       `bun:"departure,type:jsonb"`
 	Arrival                   value.Location       `bun:"arrival,type:jsonb"`
 	PaymentSummary            value.PaymentSummary `bun:"payment_summary,type:jsonb"`
+	CorporateId               sql.NullString       `bun:"corporate_id"`
 	RequestBasePrice          int                  `bun:"request_base_price"`
 	RequestMinAdditionalPrice int                  `bun:"request_min_additional_price"`
 	RequestMaxAdditionalPrice int                  `bun:"request_max_additional_price"`
 	BasePrice                 int                  `bun:"base_price"`
 	AdditionalPrice           int                  `bun:"additional_price"`
+	CouponDiscountPrice       int                  `bun:"coupon_discount_price"`
 	CurrentState              enum.TaxiCallState   `bun:"taxi_call_state"`
+	Version                   int                  `bun:"version"` // Optimistic lock, increased by repository on update
 	CreateTime                time.Time            `bun:"create_time"`
 	UpdateTime                time.Time            `bun:"update_time"`
 }
@@ -58,6 +62,7 @@ type TaxiCallTicket struct {
 	TaxiCallRequestId string    `bun:"taxi_call_request_id"`
 	Attempt           int       `bun:"attempt"`
 	AdditionalPrice   int       `bun:"additional_price"`
+	Version           int       `bun:"version"` // Optimistic lock, increased by repository on upsert
 	CreateTime        time.Time `bun:"create_time"`
 	UpdateTime        time.Time `bun:"update_time"`
 }
@@ -68,6 +73,7 @@ func (t TaxiCallTicket) Copy() TaxiCallTicket {
 		TaxiCallRequestId: t.TaxiCallRequestId,
 		Attempt:           t.Attempt,
 		AdditionalPrice:   t.AdditionalPrice,
+		Version:           t.Version,
 		CreateTime:        t.CreateTime,
 		UpdateTime:        t.UpdateTime,
 	}
@@ -91,6 +97,7 @@ func (t *TaxiCallTicket) IncreasePrice(maxPrice int, updateTime time.Time) bool
 	t.Id = utils.MustNewUUID()
 	t.Attempt = 1
 	t.AdditionalPrice += PriceStep
+	t.Version = 0
 	t.CreateTime = updateTime
 	t.UpdateTime = updateTime
 
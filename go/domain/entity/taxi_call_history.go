@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/taco-labs/taco/go/utils"
+	"github.com/uptrace/bun"
+)
+
+// TaxiCallHistory is append only record of taxi call request's transition.
+// Ticket issued by dispatch worker is also recorded with the same from & to state.
+type TaxiCallHistory struct {
+	bun.BaseModel `bun:"table:taxi_call_history"`
+
+	Id                string                 `bun:"id,pk"`
+	TaxiCallRequestId string                 `bun:"taxi_call_request_id"`
+	ActorType         enum.TaxiCallActorType `bun:"actor_type"`
+	ActorId           string                 `bun:"actor_id,nullzero"`
+	FromState         enum.TaxiCallState     `bun:"from_state,nullzero"` // Null on creation
+	ToState           enum.TaxiCallState     `bun:"to_state"`
+	TicketId          string                 `bun:"ticket_id,nullzero"`
+	BasePrice         int                    `bun:"base_price"`
+	AdditionalPrice   int                    `bun:"additional_price"`
+	Location          *value.Point           `bun:"location,type:jsonb"` // Location of the actor if known
+	CreateTime        time.Time              `bun:"create_time"`
+}
+
+func NewTaxiCallHistory(taxiCallRequest TaxiCallRequest, fromState enum.TaxiCallState,
+	actorType enum.TaxiCallActorType, actorId string) TaxiCallHistory {
+	return TaxiCallHistory{
+		Id:                utils.MustNewUUID(),
+		TaxiCallRequestId: taxiCallRequest.Id,
+		ActorType:         actorType,
+		ActorId:           actorId,
+		FromState:         fromState,
+		ToState:           taxiCallRequest.CurrentState,
+		BasePrice:         taxiCallRequest.BasePrice,
+		AdditionalPrice:   taxiCallRequest.AdditionalPrice,
+		CreateTime:        taxiCallRequest.UpdateTime,
+	}
+}
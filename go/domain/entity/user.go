@@ -10,19 +10,21 @@ import (
 type User struct {
 	bun.BaseModel `bun:"table:\"user\""`
 
-	Id            string      `bun:"id,pk"`
-	FirstName     string      `bun:"first_name"`
-	LastName      string      `bun:"last_name"`
-	BirthDay      string      `bun:"birthday"`
-	Phone         string      `bun:"phone"`
-	Gender        string      `bun:"gender"`
-	AppOs         enum.OsType `bun:"app_os"`
-	AppVersion    string      `bun:"app_version"`
-	AppFcmToken   string      `bun:"app_fcm_token"`
-	UserUniqueKey string      `bun:"user_unique_key"`
-	CreateTime    time.Time   `bun:"create_time"`
-	UpdateTime    time.Time   `bun:"update_time"`
-	DeleteTime    time.Time   `bun:"delete_time"`
+	Id            string        `bun:"id,pk"`
+	FirstName     string        `bun:"first_name"`
+	LastName      string        `bun:"last_name"`
+	BirthDay      string        `bun:"birthday"`
+	Phone         string        `bun:"phone"`
+	Gender        string        `bun:"gender"`
+	AppOs         enum.OsType   `bun:"app_os"`
+	AppVersion    string        `bun:"app_version"`
+	AppFcmToken   string        `bun:"app_fcm_token"`
+	Language      enum.Language `bun:"language"`
+	UserUniqueKey string        `bun:"user_unique_key"`
+	ReferralCode  string        `bun:"referral_code"`
+	CreateTime    time.Time     `bun:"create_time"`
+	UpdateTime    time.Time     `bun:"update_time"`
+	DeleteTime    time.Time     `bun:"delete_time"`
 }
 
 type UserPayment struct {
@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/uptrace/bun"
+)
+
+type Vehicle struct {
+	bun.BaseModel `bun:"table:vehicle"`
+
+	DriverId     string            `bun:"driver_id,pk"`
+	PlateNumber  string            `bun:"plate_number"` // eg. 서울12가3456
+	Model        string            `bun:"model"`
+	Color        string            `bun:"color"`
+	Capacity     int               `bun:"capacity"` // Number of passenger seats
+	VehicleClass enum.VehicleClass `bun:"vehicle_class"`
+	Verified     bool              `bun:"verified"` // Verified by backoffice, reset when driver updates vehicle
+	VerifyTime   time.Time         `bun:"verify_time"`
+	CreateTime   time.Time         `bun:"create_time"`
+	UpdateTime   time.Time         `bun:"update_time"`
+}
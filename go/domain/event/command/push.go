@@ -11,6 +11,9 @@ import (
 const (
 	EventUri_UserTaxiCallNotification   = "TaxiCallNotification/User"
 	EventUri_DriverTaxiCallNotification = "TaxiCallNotification/Driver"
+
+	// Notifications to drivers from one dispatch round, sent with single bulk request
+	EventUri_DriverTaxiCallBulkNotification = "TaxiCallNotification/DriverBulk"
 )
 
 type UserTaxiCallNotificationCommand struct {
@@ -25,6 +28,11 @@ type UserTaxiCallNotificationCommand struct {
 	Departure            value.Location `json:"departureAddress,omitempty"`
 	Arrival              value.Location `json:"arrivalAddress,omitempty"`
 	SearchRangeInMinutes int            `json:"searchRangeInMinutes,omitempty"`
+	VehiclePlateNumber   string         `json:"vehiclePlateNumber,omitempty"`
+	VehicleModel         string         `json:"vehicleModel,omitempty"`
+	VehicleColor         string         `json:"vehicleColor,omitempty"`
+	VehicleCapacity      int            `json:"vehicleCapacity,omitempty"`
+	VehicleClass         string         `json:"vehicleClass,omitempty"`
 }
 
 type DriverTaxiCallNotificationCommand struct {
@@ -33,6 +41,7 @@ type DriverTaxiCallNotificationCommand struct {
 	TaxiCallRequestId string         `json:"taxiCallRequestId"`
 	TaxiCallTicketId  string         `json:"taxiCallTicketId"`
 	TaxiCallState     string         `json:"taxiCallState"`
+	TicketExpired     bool           `json:"ticketExpired,omitempty"`
 	DriverLocation    value.Point    `json:"driverLocation"`
 	RequestBasePrice  int            `json:"requestBasePrice,omitempty"`
 	AdditionalPrice   int            `json:"additionalPrice,omitempty"`
@@ -40,6 +49,21 @@ type DriverTaxiCallNotificationCommand struct {
 	Arrival           value.Location `json:"arrivalAddress,omitempty"`
 }
 
+type DriverTaxiCallBulkNotificationCommand struct {
+	Commands []DriverTaxiCallNotificationCommand `json:"commands"`
+}
+
+func (d DriverTaxiCallBulkNotificationCommand) ToEvent() entity.Event {
+	cmdJson, _ := json.Marshal(d)
+
+	return entity.Event{
+		MessageId:    utils.MustNewUUID(),
+		EventUri:     EventUri_DriverTaxiCallBulkNotification,
+		DelaySeconds: 0,
+		Payload:      cmdJson,
+	}
+}
+
 func NewUserTaxiCallNotificationCommand(taxiCallRequest entity.TaxiCallRequest,
 	taxiCallTicket entity.TaxiCallTicket,
 	driverTaxiCallContext entity.DriverTaxiCallContext) entity.Event {
@@ -55,6 +79,11 @@ func NewUserTaxiCallNotificationCommand(taxiCallRequest entity.TaxiCallRequest,
 		Departure:            taxiCallRequest.Departure,
 		Arrival:              taxiCallRequest.Arrival,
 		SearchRangeInMinutes: taxiCallTicket.GetRadiusMinutes(),
+		VehiclePlateNumber:   taxiCallRequest.Vehicle.PlateNumber,
+		VehicleModel:         taxiCallRequest.Vehicle.Model,
+		VehicleColor:         taxiCallRequest.Vehicle.Color,
+		VehicleCapacity:      taxiCallRequest.Vehicle.Capacity,
+		VehicleClass:         string(taxiCallRequest.Vehicle.VehicleClass),
 	}
 
 	cmdJson, _ := json.Marshal(cmd)
@@ -70,25 +99,62 @@ func NewUserTaxiCallNotificationCommand(taxiCallRequest entity.TaxiCallRequest,
 func NewDriverTaxiCallNotificationCommand(taxiCallRequest entity.TaxiCallRequest,
 	taxiCallTicket entity.TaxiCallTicket,
 	driverTaxiCallContext entity.DriverTaxiCallContext) entity.Event {
-	cmd := DriverTaxiCallNotificationCommand{
+	return newDriverTaxiCallNotificationCommand(taxiCallRequest, taxiCallTicket, driverTaxiCallContext, false)
+}
+
+func NewDriverTaxiCallBulkNotificationCommand(taxiCallRequest entity.TaxiCallRequest,
+	taxiCallTicket entity.TaxiCallTicket,
+	driverTaxiCallContexts []entity.DriverTaxiCallContext) entity.Event {
+	return newDriverTaxiCallBulkNotificationCommand(taxiCallRequest, taxiCallTicket, driverTaxiCallContexts, false)
+}
+
+// NewDriverTaxiCallBulkTicketExpiredCommand notifies drivers that the ticket received before is no longer acceptable
+func NewDriverTaxiCallBulkTicketExpiredCommand(taxiCallRequest entity.TaxiCallRequest,
+	taxiCallTicket entity.TaxiCallTicket,
+	driverTaxiCallContexts []entity.DriverTaxiCallContext) entity.Event {
+	return newDriverTaxiCallBulkNotificationCommand(taxiCallRequest, taxiCallTicket, driverTaxiCallContexts, true)
+}
+
+func newDriverTaxiCallBulkNotificationCommand(taxiCallRequest entity.TaxiCallRequest,
+	taxiCallTicket entity.TaxiCallTicket,
+	driverTaxiCallContexts []entity.DriverTaxiCallContext, ticketExpired bool) entity.Event {
+	cmds := make([]DriverTaxiCallNotificationCommand, 0, len(driverTaxiCallContexts))
+	for _, driverTaxiCallContext := range driverTaxiCallContexts {
+		cmds = append(cmds, newDriverTaxiCallNotification(taxiCallRequest, taxiCallTicket, driverTaxiCallContext, ticketExpired))
+	}
+
+	return DriverTaxiCallBulkNotificationCommand{Commands: cmds}.ToEvent()
+}
+
+func newDriverTaxiCallNotificationCommand(taxiCallRequest entity.TaxiCallRequest,
+	taxiCallTicket entity.TaxiCallTicket,
+	driverTaxiCallContext entity.DriverTaxiCallContext, ticketExpired bool) entity.Event {
+	cmd := newDriverTaxiCallNotification(taxiCallRequest, taxiCallTicket, driverTaxiCallContext, ticketExpired)
+
+	cmdJson, _ := json.Marshal(cmd)
+
+	return entity.Event{
+		MessageId:    utils.MustNewUUID(),
+		EventUri:     EventUri_DriverTaxiCallNotification,
+		DelaySeconds: 0,
+		Payload:      cmdJson,
+	}
+}
+
+func newDriverTaxiCallNotification(taxiCallRequest entity.TaxiCallRequest,
+	taxiCallTicket entity.TaxiCallTicket,
+	driverTaxiCallContext entity.DriverTaxiCallContext, ticketExpired bool) DriverTaxiCallNotificationCommand {
+	return DriverTaxiCallNotificationCommand{
 		DriverId:          driverTaxiCallContext.DriverId,
 		UserId:            taxiCallRequest.UserId,
 		TaxiCallRequestId: taxiCallRequest.Id,
 		TaxiCallTicketId:  taxiCallTicket.Id,
 		TaxiCallState:     string(taxiCallRequest.CurrentState),
+		TicketExpired:     ticketExpired,
 		DriverLocation:    driverTaxiCallContext.Location,
 		RequestBasePrice:  taxiCallRequest.RequestBasePrice,
 		AdditionalPrice:   taxiCallTicket.AdditionalPrice,
 		Departure:         taxiCallRequest.Departure,
 		Arrival:           taxiCallRequest.Arrival,
 	}
-
-	cmdJson, _ := json.Marshal(cmd)
-
-	return entity.Event{
-		MessageId:    utils.MustNewUUID(),
-		EventUri:     EventUri_DriverTaxiCallNotification,
-		DelaySeconds: 0,
-		Payload:      cmdJson,
-	}
 }
@@ -0,0 +1,39 @@
+package command
+
+import (
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/value"
+)
+
+var retryPolicies = map[string]value.RetryPolicy{
+	// Dispatch is time sensitive, stale retry is useless
+	EventUri_TaxiCallProcess: {
+		MaxRetryCount: 3,
+		BaseDelay:     time.Second,
+		MaxDelay:      10 * time.Second,
+	},
+	EventUri_UserTaxiCallNotification: {
+		MaxRetryCount: 3,
+		BaseDelay:     2 * time.Second,
+		MaxDelay:      30 * time.Second,
+	},
+	EventUri_DriverTaxiCallNotification: {
+		MaxRetryCount: 3,
+		BaseDelay:     time.Second,
+		MaxDelay:      10 * time.Second,
+	},
+	EventUri_DriverTaxiCallBulkNotification: {
+		MaxRetryCount: 3,
+		BaseDelay:     time.Second,
+		MaxDelay:      10 * time.Second,
+	},
+}
+
+// RetryPolicyOf returns retry policy of the event uri, default policy if not registered
+func RetryPolicyOf(eventUri string) value.RetryPolicy {
+	if policy, ok := retryPolicies[eventUri]; ok {
+		return policy
+	}
+	return value.DefaultRetryPolicy
+}
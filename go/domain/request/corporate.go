@@ -0,0 +1,42 @@
+package request
+
+import (
+	"fmt"
+
+	"github.com/taco-labs/taco/go/domain/value"
+)
+
+type CreateCorporateRequest struct {
+	Name         string `json:"name"`
+	BillingEmail string `json:"billingEmail"`
+}
+
+type UpdateCorporatePolicyRequest struct {
+	CorporateId     string   `param:"corporateId"`
+	StartHour       int      `json:"startHour"`
+	EndHour         int      `json:"endHour"`
+	Regions         []string `json:"regions"`
+	MaxPricePerRide int      `json:"maxPricePerRide"`
+}
+
+func (u UpdateCorporatePolicyRequest) Validate() error {
+	if u.StartHour < 0 || u.StartHour > 23 || u.EndHour < 1 || u.EndHour > 24 || u.StartHour == u.EndHour {
+		return fmt.Errorf("%w: invalid time window", value.ErrInvalidOperation)
+	}
+
+	if u.MaxPricePerRide < 0 {
+		return fmt.Errorf("%w: negative max price per ride", value.ErrInvalidOperation)
+	}
+
+	return nil
+}
+
+type CorporateMemberRequest struct {
+	CorporateId string `param:"corporateId"`
+	UserId      string `param:"userId"`
+}
+
+type GenerateCorporateInvoiceRequest struct {
+	CorporateId  string `param:"corporateId"`
+	BillingMonth string `json:"billingMonth"`
+}
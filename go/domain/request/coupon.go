@@ -0,0 +1,52 @@
+package request
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+)
+
+type CreateCouponRequest struct {
+	Code             string    `json:"code"`
+	Name             string    `json:"name"`
+	DiscountType     string    `json:"discountType"`
+	DiscountAmount   int       `json:"discountAmount"`
+	MaxDiscountPrice int       `json:"maxDiscountPrice"`
+	UsageLimit       int       `json:"usageLimit"`
+	PerUserLimit     int       `json:"perUserLimit"`
+	UserId           string    `json:"userId"`
+	Regions          []string  `json:"regions"`
+	StartTime        time.Time `json:"startTime"`
+	ExpireTime       time.Time `json:"expireTime"`
+}
+
+func (c CreateCouponRequest) Validate() error {
+	if c.Code == "" {
+		return fmt.Errorf("%w: empty coupon code", value.ErrInvalidOperation)
+	}
+
+	switch enum.CouponDiscountTypeFromString(c.DiscountType) {
+	case enum.CouponDiscountType_FIXED:
+		if c.DiscountAmount <= 0 {
+			return fmt.Errorf("%w: invalid discount amount", value.ErrInvalidOperation)
+		}
+	case enum.CouponDiscountType_PERCENTAGE:
+		if c.DiscountAmount <= 0 || c.DiscountAmount > 100 {
+			return fmt.Errorf("%w: invalid discount rate", value.ErrInvalidOperation)
+		}
+	default:
+		return fmt.Errorf("%w: unknown discount type", value.ErrInvalidOperation)
+	}
+
+	if c.MaxDiscountPrice < 0 || c.UsageLimit < 0 || c.PerUserLimit < 0 {
+		return fmt.Errorf("%w: negative limit", value.ErrInvalidOperation)
+	}
+
+	if !c.StartTime.Before(c.ExpireTime) {
+		return fmt.Errorf("%w: invalid coupon period", value.ErrInvalidOperation)
+	}
+
+	return nil
+}
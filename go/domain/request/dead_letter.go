@@ -0,0 +1,7 @@
+package request
+
+type ListDeadLetterRequest struct {
+	Topic     string `query:"topic"`
+	Count     int    `query:"count"`
+	PageToken string `query:"pageToken"`
+}
@@ -1,5 +1,14 @@
 package request
 
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+)
+
 // TODO(taekyeom) validation
 type DriverSignupRequest struct {
 	DriverType              string `json:"driverType"`
@@ -11,6 +20,7 @@ type DriverSignupRequest struct {
 	AppOs                   string `json:"appOs"`
 	AppVersion              string `json:"appVersion"`
 	AppFcmToken             string `json:"appFcmToken"`
+	Language                string `json:"language"`
 	DriverLicenseId         string `json:"driverLicenseId"`
 	SmsVerificationStateKey string `json:"smsVerificationStateKey"`
 }
@@ -20,6 +30,7 @@ type DriverUpdateRequest struct {
 	AppOs       string `json:"appOs"`
 	AppVersion  string `json:"appVersion"`
 	AppFcmToken string `json:"appFcmToken"`
+	Language    string `json:"language"`
 }
 
 type DriverOnDutyUpdateRequest struct {
@@ -44,3 +55,64 @@ type DriverSettlementAccountUpdateRequest struct {
 	Bank          string `json:"bank"`
 	AccountNumber string `json:"accountNumber"`
 }
+
+type DriverDocumentUploadRequest struct {
+	DriverId     string
+	DocumentType string
+	ContentType  string
+	File         io.Reader
+}
+
+type ApproveDriverDocumentRequest struct {
+	DriverId     string    `param:"driverId"`
+	DocumentType string    `param:"documentType"`
+	ExpireTime   time.Time `json:"expireTime"`
+}
+
+type RejectDriverDocumentRequest struct {
+	DriverId     string `param:"driverId"`
+	DocumentType string `param:"documentType"`
+	RejectReason string `json:"rejectReason"`
+}
+
+type ListDriverDocumentReviewQueueRequest struct {
+	Count int `query:"count"`
+}
+
+type UpsertVehicleRequest struct {
+	DriverId     string `param:"driverId"`
+	PlateNumber  string `json:"plateNumber"`
+	Model        string `json:"model"`
+	Color        string `json:"color"`
+	Capacity     int    `json:"capacity"`
+	VehicleClass string `json:"vehicleClass"`
+}
+
+func (u UpsertVehicleRequest) Validate() error {
+	if u.PlateNumber == "" || u.Model == "" || u.Color == "" {
+		return fmt.Errorf("%w: plate number, model and color are required", value.ErrInvalidOperation)
+	}
+
+	if u.Capacity <= 0 {
+		return fmt.Errorf("%w: invalid capacity", value.ErrInvalidOperation)
+	}
+
+	if enum.VehicleClassFromString(u.VehicleClass) == enum.VehicleClass_UNKNOWN {
+		return fmt.Errorf("%w: unknown vehicle class", value.ErrInvalidOperation)
+	}
+
+	return nil
+}
+
+type DriverDutyStatsRequest struct {
+	DriverId string    `param:"driverId"`
+	From     time.Time `query:"from"`
+	To       time.Time `query:"to"`
+}
+
+type DemandHeatmapRequest struct {
+	DriverId      string  `param:"driverId"`
+	Latitude      float64 `query:"latitude"`
+	Longitude     float64 `query:"longitude"`
+	WindowMinutes int     `query:"windowMinutes"`
+}
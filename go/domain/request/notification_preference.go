@@ -0,0 +1,33 @@
+package request
+
+import (
+	"fmt"
+
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+)
+
+type UpdateNotificationPreferenceRequest struct {
+	PrincipalId       string
+	ProgressEnabled   bool   `json:"progressEnabled"`
+	ProgressChannel   string `json:"progressChannel"`
+	MarketingAgreed   bool   `json:"marketingAgreed"`
+	MarketingChannel  string `json:"marketingChannel"`
+	QuietHoursEnabled bool   `json:"quietHoursEnabled"`
+	QuietStartHour    int    `json:"quietStartHour"`
+	QuietEndHour      int    `json:"quietEndHour"`
+}
+
+func (u UpdateNotificationPreferenceRequest) Validate() error {
+	if u.QuietStartHour < 0 || u.QuietStartHour > 23 || u.QuietEndHour < 0 || u.QuietEndHour > 24 || u.QuietStartHour == u.QuietEndHour {
+		return fmt.Errorf("%w: invalid quiet hours", value.ErrInvalidOperation)
+	}
+
+	for _, channel := range []string{u.ProgressChannel, u.MarketingChannel} {
+		if channel != string(enum.NotificationChannel_PUSH) && channel != string(enum.NotificationChannel_SMS) {
+			return fmt.Errorf("%w: invalid notification channel %s", value.ErrInvalidOperation, channel)
+		}
+	}
+
+	return nil
+}
@@ -5,10 +5,12 @@ import (
 )
 
 type CreateTaxiCallRequest struct {
-	Dryrun    bool        `json:"dryrun"`
-	Departure value.Point `json:"departure"`
-	Arrival   value.Point `json:"arrival"`
-	PaymentId string      `json:"paymentId"`
+	Dryrun      bool        `json:"dryrun"`
+	Departure   value.Point `json:"departure"`
+	Arrival     value.Point `json:"arrival"`
+	PaymentId   string      `json:"paymentId"`
+	CorporateId string      `json:"corporateId"` // If set, taxi call is billed to corporate instead of payment
+	CouponCode  string      `json:"couponCode"`
 }
 
 // TODO (taekyeom) validation
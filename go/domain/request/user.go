@@ -10,7 +10,9 @@ type UserSignupRequest struct {
 	AppOs                   string `json:"appOs"`
 	AppVersion              string `json:"appVersion"`
 	AppFcmToken             string `json:"appFcmToken"`
+	Language                string `json:"language"`
 	SmsVerificationStateKey string `json:"smsVerificationStateKey"`
+	ReferralCode            string `json:"referralCode"`
 }
 
 type UserUpdateRequest struct {
@@ -18,6 +20,7 @@ type UserUpdateRequest struct {
 	AppOs       string `json:"appOs"`
 	AppVersion  string `json:"appVersion"`
 	AppFcmToken string `json:"appFcmToken"`
+	Language    string `json:"language"`
 }
 
 type DefaultPaymentUpdateRequest struct {
@@ -0,0 +1,79 @@
+package response
+
+import (
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+)
+
+type CorporateResponse struct {
+	Id           string    `json:"id"`
+	Name         string    `json:"name"`
+	BillingEmail string    `json:"billingEmail"`
+	CreateTime   time.Time `json:"createTime"`
+	UpdateTime   time.Time `json:"updateTime"`
+}
+
+func CorporateToResponse(corporate entity.Corporate) CorporateResponse {
+	return CorporateResponse{
+		Id:           corporate.Id,
+		Name:         corporate.Name,
+		BillingEmail: corporate.BillingEmail,
+		CreateTime:   corporate.CreateTime,
+		UpdateTime:   corporate.UpdateTime,
+	}
+}
+
+type CorporateMemberResponse struct {
+	CorporateId string    `json:"corporateId"`
+	UserId      string    `json:"userId"`
+	CreateTime  time.Time `json:"createTime"`
+}
+
+func CorporateMemberToResponse(member entity.CorporateMember) CorporateMemberResponse {
+	return CorporateMemberResponse{
+		CorporateId: member.CorporateId,
+		UserId:      member.UserId,
+		CreateTime:  member.CreateTime,
+	}
+}
+
+type CorporatePolicyResponse struct {
+	CorporateId     string    `json:"corporateId"`
+	StartHour       int       `json:"startHour"`
+	EndHour         int       `json:"endHour"`
+	Regions         []string  `json:"regions"`
+	MaxPricePerRide int       `json:"maxPricePerRide"`
+	UpdateTime      time.Time `json:"updateTime"`
+}
+
+func CorporatePolicyToResponse(policy entity.CorporatePolicy) CorporatePolicyResponse {
+	return CorporatePolicyResponse{
+		CorporateId:     policy.CorporateId,
+		StartHour:       policy.StartHour,
+		EndHour:         policy.EndHour,
+		Regions:         policy.Regions,
+		MaxPricePerRide: policy.MaxPricePerRide,
+		UpdateTime:      policy.UpdateTime,
+	}
+}
+
+type CorporateInvoiceResponse struct {
+	Id           string    `json:"id"`
+	CorporateId  string    `json:"corporateId"`
+	BillingMonth string    `json:"billingMonth"`
+	RideCount    int       `json:"rideCount"`
+	TotalPrice   int       `json:"totalPrice"`
+	CreateTime   time.Time `json:"createTime"`
+}
+
+func CorporateInvoiceToResponse(invoice entity.CorporateInvoice) CorporateInvoiceResponse {
+	return CorporateInvoiceResponse{
+		Id:           invoice.Id,
+		CorporateId:  invoice.CorporateId,
+		BillingMonth: invoice.BillingMonth,
+		RideCount:    invoice.RideCount,
+		TotalPrice:   invoice.TotalPrice,
+		CreateTime:   invoice.CreateTime,
+	}
+}
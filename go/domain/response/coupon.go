@@ -0,0 +1,50 @@
+package response
+
+import (
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+)
+
+type CouponResponse struct {
+	Id               string    `json:"id"`
+	Code             string    `json:"code"`
+	Name             string    `json:"name"`
+	DiscountType     string    `json:"discountType"`
+	DiscountAmount   int       `json:"discountAmount"`
+	MaxDiscountPrice int       `json:"maxDiscountPrice"`
+	UsageLimit       int       `json:"usageLimit"`
+	UsageCount       int       `json:"usageCount"`
+	PerUserLimit     int       `json:"perUserLimit"`
+	UserId           *string   `json:"userId"`
+	Regions          []string  `json:"regions"`
+	StartTime        time.Time `json:"startTime"`
+	ExpireTime       time.Time `json:"expireTime"`
+	CreateTime       time.Time `json:"createTime"`
+	UpdateTime       time.Time `json:"updateTime"`
+}
+
+func CouponToResponse(coupon entity.Coupon) CouponResponse {
+	return CouponResponse{
+		Id:               coupon.Id,
+		Code:             coupon.Code,
+		Name:             coupon.Name,
+		DiscountType:     string(coupon.DiscountType),
+		DiscountAmount:   coupon.DiscountAmount,
+		MaxDiscountPrice: coupon.MaxDiscountPrice,
+		UsageLimit:       coupon.UsageLimit,
+		UsageCount:       coupon.UsageCount,
+		PerUserLimit:     coupon.PerUserLimit,
+		UserId: func() *string {
+			if coupon.UserId.Valid {
+				return &coupon.UserId.String
+			}
+			return nil
+		}(),
+		Regions:    coupon.Regions,
+		StartTime:  coupon.StartTime,
+		ExpireTime: coupon.ExpireTime,
+		CreateTime: coupon.CreateTime,
+		UpdateTime: coupon.UpdateTime,
+	}
+}
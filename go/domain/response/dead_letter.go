@@ -0,0 +1,39 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+)
+
+type DeadLetterResponse struct {
+	Id               string          `json:"id"`
+	Topic            string          `json:"topic"`
+	EventUri         string          `json:"eventUri"`
+	Payload          json.RawMessage `json:"payload"`
+	RetryCount       int             `json:"retryCount"`
+	LastError        string          `json:"lastError"`
+	FirstAttemptTime time.Time       `json:"firstAttemptTime"`
+	LastAttemptTime  time.Time       `json:"lastAttemptTime"`
+	CreateTime       time.Time       `json:"createTime"`
+}
+
+type DeadLetterPageResponse struct {
+	PageToken string               `json:"pageToken"`
+	Data      []DeadLetterResponse `json:"data"`
+}
+
+func DeadLetterToResponse(deadLetter entity.DeadLetter) DeadLetterResponse {
+	return DeadLetterResponse{
+		Id:               deadLetter.Id,
+		Topic:            string(deadLetter.Topic),
+		EventUri:         deadLetter.EventUri,
+		Payload:          deadLetter.Payload,
+		RetryCount:       deadLetter.RetryCount,
+		LastError:        deadLetter.LastError,
+		FirstAttemptTime: deadLetter.FirstAttemptTime,
+		LastAttemptTime:  deadLetter.LastAttemptTime,
+		CreateTime:       deadLetter.CreateTime,
+	}
+}
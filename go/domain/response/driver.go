@@ -16,6 +16,7 @@ type DriverResponse struct {
 	Gender     string `json:"gender"`
 	AppOs      string `json:"appOs"`
 	AppVersion string `json:"osVersion"`
+	Language   string `json:"language"`
 	Active     bool   `json:"active"`
 	OnDuty     bool   `json:"onDuty"`
 }
@@ -36,6 +37,7 @@ func DriverToResponse(driver entity.Driver) DriverResponse {
 		Gender:     driver.Gender,
 		AppOs:      string(driver.AppOs),
 		AppVersion: driver.AppVersion,
+		Language:   string(driver.Language),
 		Active:     driver.Active,
 		OnDuty:     driver.OnDuty,
 	}
@@ -58,3 +60,110 @@ func DriverSettlemtnAccountToResponse(account entity.DriverSettlementAccount) Dr
 		UpdateTime:    account.UpdateTime,
 	}
 }
+
+type DriverDocumentResponse struct {
+	DriverId     string    `json:"driverId"`
+	DocumentType string    `json:"documentType"`
+	State        string    `json:"state"`
+	RejectReason string    `json:"rejectReason"`
+	ExpireTime   time.Time `json:"expireTime"`
+	ReviewTime   time.Time `json:"reviewTime"`
+	CreateTime   time.Time `json:"createTime"`
+	UpdateTime   time.Time `json:"updateTime"`
+}
+
+func DriverDocumentToResponse(document entity.DriverDocument) DriverDocumentResponse {
+	return DriverDocumentResponse{
+		DriverId:     document.DriverId,
+		DocumentType: string(document.DocumentType),
+		State:        string(document.State),
+		RejectReason: document.RejectReason,
+		ExpireTime:   document.ExpireTime,
+		ReviewTime:   document.ReviewTime,
+		CreateTime:   document.CreateTime,
+		UpdateTime:   document.UpdateTime,
+	}
+}
+
+type DriverDocumentDownloadUrlResponse struct {
+	Url string `json:"url"`
+}
+
+type VehicleResponse struct {
+	DriverId     string    `json:"driverId"`
+	PlateNumber  string    `json:"plateNumber"`
+	Model        string    `json:"model"`
+	Color        string    `json:"color"`
+	Capacity     int       `json:"capacity"`
+	VehicleClass string    `json:"vehicleClass"`
+	Verified     bool      `json:"verified"`
+	VerifyTime   time.Time `json:"verifyTime"`
+	CreateTime   time.Time `json:"createTime"`
+	UpdateTime   time.Time `json:"updateTime"`
+}
+
+func VehicleToResponse(vehicle entity.Vehicle) VehicleResponse {
+	return VehicleResponse{
+		DriverId:     vehicle.DriverId,
+		PlateNumber:  vehicle.PlateNumber,
+		Model:        vehicle.Model,
+		Color:        vehicle.Color,
+		Capacity:     vehicle.Capacity,
+		VehicleClass: string(vehicle.VehicleClass),
+		Verified:     vehicle.Verified,
+		VerifyTime:   vehicle.VerifyTime,
+		CreateTime:   vehicle.CreateTime,
+		UpdateTime:   vehicle.UpdateTime,
+	}
+}
+
+type DriverDutySessionResponse struct {
+	Id             string     `json:"id"`
+	DriverId       string     `json:"driverId"`
+	StartTime      time.Time  `json:"startTime"`
+	LastActiveTime time.Time  `json:"lastActiveTime"`
+	EndTime        *time.Time `json:"endTime"`
+	EndReason      string     `json:"endReason"`
+}
+
+func DriverDutySessionToResponse(dutySession entity.DriverDutySession) DriverDutySessionResponse {
+	return DriverDutySessionResponse{
+		Id:             dutySession.Id,
+		DriverId:       dutySession.DriverId,
+		StartTime:      dutySession.StartTime,
+		LastActiveTime: dutySession.LastActiveTime,
+		EndTime: func() *time.Time {
+			if dutySession.Active() {
+				return nil
+			}
+			return &dutySession.EndTime
+		}(),
+		EndReason: string(dutySession.EndReason),
+	}
+}
+
+type DriverDutyStatsResponse struct {
+	DriverId      string    `json:"driverId"`
+	From          time.Time `json:"from"`
+	To            time.Time `json:"to"`
+	SessionCount  int       `json:"sessionCount"`
+	RideCount     int       `json:"rideCount"`
+	OnlineSeconds int       `json:"onlineSeconds"`
+	RideSeconds   int       `json:"rideSeconds"`
+	IdleSeconds   int       `json:"idleSeconds"`
+	Utilization   float64   `json:"utilization"`
+}
+
+func DriverDutyStatsToResponse(stats entity.DriverDutyStats) DriverDutyStatsResponse {
+	return DriverDutyStatsResponse{
+		DriverId:      stats.DriverId,
+		From:          stats.From,
+		To:            stats.To,
+		SessionCount:  stats.SessionCount,
+		RideCount:     stats.RideCount,
+		OnlineSeconds: int(stats.OnlineDuration.Seconds()),
+		RideSeconds:   int(stats.RideDuration.Seconds()),
+		IdleSeconds:   int(stats.IdleDuration.Seconds()),
+		Utilization:   stats.Utilization,
+	}
+}
@@ -0,0 +1,38 @@
+package response
+
+import (
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+)
+
+type NotificationPreferenceResponse struct {
+	ProgressEnabled    bool       `json:"progressEnabled"`
+	ProgressChannel    string     `json:"progressChannel"`
+	MarketingAgreed    bool       `json:"marketingAgreed"`
+	MarketingAgreeTime *time.Time `json:"marketingAgreeTime"`
+	MarketingChannel   string     `json:"marketingChannel"`
+	QuietHoursEnabled  bool       `json:"quietHoursEnabled"`
+	QuietStartHour     int        `json:"quietStartHour"`
+	QuietEndHour       int        `json:"quietEndHour"`
+	UpdateTime         time.Time  `json:"updateTime"`
+}
+
+func NotificationPreferenceToResponse(preference entity.NotificationPreference) NotificationPreferenceResponse {
+	return NotificationPreferenceResponse{
+		ProgressEnabled: preference.ProgressEnabled,
+		ProgressChannel: string(preference.ProgressChannel),
+		MarketingAgreed: preference.MarketingAgreed,
+		MarketingAgreeTime: func() *time.Time {
+			if preference.MarketingAgreeTime.IsZero() {
+				return nil
+			}
+			return &preference.MarketingAgreeTime
+		}(),
+		MarketingChannel:  string(preference.MarketingChannel),
+		QuietHoursEnabled: preference.QuietHoursEnabled,
+		QuietStartHour:    preference.QuietStartHour,
+		QuietEndHour:      preference.QuietEndHour,
+		UpdateTime:        preference.UpdateTime,
+	}
+}
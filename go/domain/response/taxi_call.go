@@ -24,11 +24,14 @@ type TaxiCallRequestResponse struct {
 	Departure                 value.Location         `json:"departure"`
 	Arrival                   value.Location         `json:"arrival"`
 	Payment                   PaymentSummaryResponse `json:"payment"`
+	CorporateId               *string                `json:"corporateId"`
 	RequestBasePrice          int                    `json:"requestBasePrice"`
 	RequestMinAdditionalPrice int                    `json:"requestMinAdditionalPrice"`
 	RequestMaxAdditionalPrice int                    `json:"requestMaxAdditionalPrice"`
 	BasePrice                 int                    `json:"basePrice"`
 	AdditionalPrice           int                    `json:"additionalPrice"`
+	CouponDiscountPrice       int                    `json:"couponDiscountPrice"`
+	Vehicle                   *VehicleResponse       `json:"vehicle"`
 	CurrentState              string                 `json:"currentState"`
 	CreateTime                time.Time              `json:"createTime"`
 	UpdateTime                time.Time              `json:"updateTime"`
@@ -61,16 +64,76 @@ func TaxiCallRequestToResponse(taxiCallRequest entity.TaxiCallRequest) TaxiCallR
 			}
 			return nil
 		}(),
-		Departure:                 taxiCallRequest.Departure,
-		Arrival:                   taxiCallRequest.Arrival,
-		Payment:                   PaymentSummaryToResponse(taxiCallRequest.PaymentSummary),
+		Departure: taxiCallRequest.Departure,
+		Arrival:   taxiCallRequest.Arrival,
+		Payment:   PaymentSummaryToResponse(taxiCallRequest.PaymentSummary),
+		CorporateId: func() *string {
+			if taxiCallRequest.CorporateId.Valid {
+				return &taxiCallRequest.CorporateId.String
+			}
+			return nil
+		}(),
 		RequestBasePrice:          taxiCallRequest.RequestBasePrice,
 		RequestMinAdditionalPrice: taxiCallRequest.RequestMinAdditionalPrice,
 		RequestMaxAdditionalPrice: taxiCallRequest.RequestMaxAdditionalPrice,
 		BasePrice:                 taxiCallRequest.BasePrice,
 		AdditionalPrice:           taxiCallRequest.AdditionalPrice,
-		CurrentState:              string(taxiCallRequest.CurrentState),
-		CreateTime:                taxiCallRequest.CreateTime,
-		UpdateTime:                taxiCallRequest.UpdateTime,
+		CouponDiscountPrice:       taxiCallRequest.CouponDiscountPrice,
+		Vehicle: func() *VehicleResponse {
+			if taxiCallRequest.Vehicle.DriverId != "" {
+				resp := VehicleToResponse(taxiCallRequest.Vehicle)
+				return &resp
+			}
+			return nil
+		}(),
+		CurrentState: string(taxiCallRequest.CurrentState),
+		CreateTime:   taxiCallRequest.CreateTime,
+		UpdateTime:   taxiCallRequest.UpdateTime,
+	}
+}
+
+type TaxiCallHistoryResponse struct {
+	Id                string       `json:"id"`
+	TaxiCallRequestId string       `json:"taxiCallRequestId"`
+	ActorType         string       `json:"actorType"`
+	ActorId           *string      `json:"actorId"`
+	FromState         *string      `json:"fromState"`
+	ToState           string       `json:"toState"`
+	TicketId          *string      `json:"ticketId"`
+	BasePrice         int          `json:"basePrice"`
+	AdditionalPrice   int          `json:"additionalPrice"`
+	Location          *value.Point `json:"location"`
+	CreateTime        time.Time    `json:"createTime"`
+}
+
+func TaxiCallHistoryToResponse(history entity.TaxiCallHistory) TaxiCallHistoryResponse {
+	return TaxiCallHistoryResponse{
+		Id:                history.Id,
+		TaxiCallRequestId: history.TaxiCallRequestId,
+		ActorType:         string(history.ActorType),
+		ActorId: func() *string {
+			if history.ActorId != "" {
+				return &history.ActorId
+			}
+			return nil
+		}(),
+		FromState: func() *string {
+			if history.FromState != "" {
+				fromState := string(history.FromState)
+				return &fromState
+			}
+			return nil
+		}(),
+		ToState: string(history.ToState),
+		TicketId: func() *string {
+			if history.TicketId != "" {
+				return &history.TicketId
+			}
+			return nil
+		}(),
+		BasePrice:       history.BasePrice,
+		AdditionalPrice: history.AdditionalPrice,
+		Location:        history.Location,
+		CreateTime:      history.CreateTime,
 	}
 }
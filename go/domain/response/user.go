@@ -12,26 +12,30 @@ type SmsVerificationRequestResponse struct {
 }
 
 type UserResponse struct {
-	Id         string `json:"id"`
-	FirstName  string `json:"firstName"`
-	LastName   string `json:"lastName"`
-	BirthDay   string `json:"birthday"`
-	Phone      string `json:"phone"`
-	Gender     string `json:"gender"`
-	AppOs      string `json:"appOs"`
-	AppVersion string `json:"osVersion"`
+	Id           string `json:"id"`
+	FirstName    string `json:"firstName"`
+	LastName     string `json:"lastName"`
+	BirthDay     string `json:"birthday"`
+	Phone        string `json:"phone"`
+	Gender       string `json:"gender"`
+	AppOs        string `json:"appOs"`
+	AppVersion   string `json:"osVersion"`
+	Language     string `json:"language"`
+	ReferralCode string `json:"referralCode"`
 }
 
 func UserToResponse(user entity.User) UserResponse {
 	return UserResponse{
-		Id:         user.Id,
-		FirstName:  user.FirstName,
-		LastName:   user.LastName,
-		BirthDay:   user.BirthDay,
-		Phone:      user.Phone,
-		Gender:     user.Gender,
-		AppOs:      string(user.AppOs),
-		AppVersion: user.AppVersion,
+		Id:           user.Id,
+		FirstName:    user.FirstName,
+		LastName:     user.LastName,
+		BirthDay:     user.BirthDay,
+		Phone:        user.Phone,
+		Gender:       user.Gender,
+		AppOs:        string(user.AppOs),
+		AppVersion:   user.AppVersion,
+		Language:     string(user.Language),
+		ReferralCode: user.ReferralCode,
 	}
 }
 
@@ -74,3 +78,19 @@ type ListCardPaymentResponse struct {
 type DeleteCardPaymentResponse struct {
 	PaymentId string `json:"paymentId"`
 }
+
+type ReferralStatsResponse struct {
+	Total    int `json:"total"`
+	Pending  int `json:"pending"`
+	Rewarded int `json:"rewarded"`
+	Rejected int `json:"rejected"`
+}
+
+func ReferralStatsToResponse(stats entity.ReferralStats) ReferralStatsResponse {
+	return ReferralStatsResponse{
+		Total:    stats.Total,
+		Pending:  stats.Pending,
+		Rewarded: stats.Rewarded,
+		Rejected: stats.Rejected,
+	}
+}
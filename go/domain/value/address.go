@@ -20,6 +20,16 @@ func (r Address) AvailableRegion() bool {
 	return r.RegionDepth1 == "서울" && (r.RegionDepth2 == "서초구" || r.RegionDepth2 == "강남구")
 }
 
+// InRegions checks address is in one of regions, formatted as "{RegionDepth1}" or "{RegionDepth1} {RegionDepth2}" (eg. "서울", "서울 강남구")
+func (r Address) InRegions(regions []string) bool {
+	for _, region := range regions {
+		if region == r.RegionDepth1 || region == fmt.Sprintf("%s %s", r.RegionDepth1, r.RegionDepth2) {
+			return true
+		}
+	}
+	return false
+}
+
 type AddressSummary struct {
 	PlaceName   string `json:"placeName"`
 	AddressName string `json:"addressName"`
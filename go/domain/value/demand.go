@@ -0,0 +1,18 @@
+package value
+
+import "time"
+
+// DemandCell is aggregated demand of a grid cell. Individual requests are never exposed.
+type DemandCell struct {
+	Center       Point `json:"center"`
+	RequestCount int   `json:"requestCount"` // Requests departed from the cell within the window
+	FailedCount  int   `json:"failedCount"`  // Requests failed to find driver within the window
+	ActiveCount  int   `json:"activeCount"`  // Requests currently waiting for driver
+}
+
+type DemandHeatmap struct {
+	From     time.Time    `json:"from"`
+	To       time.Time    `json:"to"`
+	CellSize float64      `json:"cellSize"` // Cell size in degree
+	Cells    []DemandCell `json:"cells"`
+}
@@ -42,7 +42,6 @@ func (t TaxiCallState) Complete() bool {
 	return !t.Active()
 }
 
-// TODO(taekyeom) handle user / driver cancel
 func (t TaxiCallState) TryChangeState(nextState TaxiCallState) bool {
 	switch t {
 	case TaxiCallState_Requested:
@@ -51,6 +50,7 @@ func (t TaxiCallState) TryChangeState(nextState TaxiCallState) bool {
 			nextState == TaxiCallState_FAILED
 	case TaxiCallState_DRIVER_TO_DEPARTURE:
 		return nextState == TaxiCallState_DRIVER_TO_ARRIVAL ||
+			nextState == TaxiCallState_USER_CANCELLED ||
 			nextState == TaxiCallState_DRIVER_CANCELLED
 	case TaxiCallState_DRIVER_TO_ARRIVAL:
 		return nextState == TaxiCallState_DONE
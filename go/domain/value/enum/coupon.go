@@ -0,0 +1,35 @@
+package enum
+
+type CouponDiscountType string
+
+var (
+	CouponDiscountType_UNKNOWN CouponDiscountType = "UNKNOWN"
+
+	CouponDiscountType_FIXED CouponDiscountType = "FIXED"
+
+	CouponDiscountType_PERCENTAGE CouponDiscountType = "PERCENTAGE"
+)
+
+func CouponDiscountTypeFromString(discountTypeStr string) CouponDiscountType {
+	switch discountTypeStr {
+	case string(CouponDiscountType_FIXED):
+		return CouponDiscountType_FIXED
+	case string(CouponDiscountType_PERCENTAGE):
+		return CouponDiscountType_PERCENTAGE
+	default:
+		return CouponDiscountType_UNKNOWN
+	}
+}
+
+type CouponUsageState string
+
+var (
+	// Reserved at taxi call creation, not yet charged
+	CouponUsageState_RESERVED CouponUsageState = "RESERVED"
+
+	// Consumed at taxi call completion
+	CouponUsageState_USED CouponUsageState = "USED"
+
+	// Released by cancel or failure of taxi call
+	CouponUsageState_RELEASED CouponUsageState = "RELEASED"
+)
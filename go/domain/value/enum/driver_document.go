@@ -0,0 +1,56 @@
+package enum
+
+type DriverDocumentType string
+
+var (
+	DriverDocumentType_UNKNOWN DriverDocumentType = "UNKNOWN"
+
+	// 운전면허증
+	DriverDocumentType_DRIVER_LICENSE DriverDocumentType = "DRIVER_LICENSE"
+
+	// 자동차 등록증
+	DriverDocumentType_VEHICLE_REGISTRATION DriverDocumentType = "VEHICLE_REGISTRATION"
+
+	// 자동차 보험 증권
+	DriverDocumentType_INSURANCE DriverDocumentType = "INSURANCE"
+)
+
+func DriverDocumentTypeFromString(documentTypeStr string) DriverDocumentType {
+	switch documentTypeStr {
+	case string(DriverDocumentType_DRIVER_LICENSE):
+		return DriverDocumentType_DRIVER_LICENSE
+	case string(DriverDocumentType_VEHICLE_REGISTRATION):
+		return DriverDocumentType_VEHICLE_REGISTRATION
+	case string(DriverDocumentType_INSURANCE):
+		return DriverDocumentType_INSURANCE
+	default:
+		return DriverDocumentType_UNKNOWN
+	}
+}
+
+type DriverDocumentState string
+
+var (
+	DriverDocumentState_UNKNOWN DriverDocumentState = "UNKNOWN"
+
+	// Uploaded by driver, waiting for backoffice review
+	DriverDocumentState_PENDING DriverDocumentState = "PENDING"
+
+	DriverDocumentState_APPROVED DriverDocumentState = "APPROVED"
+
+	// Rejected by backoffice with reason, driver should upload again
+	DriverDocumentState_REJECTED DriverDocumentState = "REJECTED"
+)
+
+func DriverDocumentStateFromString(stateStr string) DriverDocumentState {
+	switch stateStr {
+	case string(DriverDocumentState_PENDING):
+		return DriverDocumentState_PENDING
+	case string(DriverDocumentState_APPROVED):
+		return DriverDocumentState_APPROVED
+	case string(DriverDocumentState_REJECTED):
+		return DriverDocumentState_REJECTED
+	default:
+		return DriverDocumentState_UNKNOWN
+	}
+}
@@ -0,0 +1,14 @@
+package enum
+
+type DriverDutyEndReason string
+
+var (
+	// Driver turned off duty by themselves
+	DriverDutyEndReason_MANUAL DriverDutyEndReason = "MANUAL"
+
+	// No activity (location update) from driver for a while
+	DriverDutyEndReason_TIMEOUT DriverDutyEndReason = "TIMEOUT"
+
+	// Turned off duty by backoffice
+	DriverDutyEndReason_FORCED DriverDutyEndReason = "FORCED"
+)
@@ -0,0 +1,21 @@
+package enum
+
+// EventTopic is the queue which an event is published to
+type EventTopic string
+
+var (
+	EventTopic_UNKNOWN      EventTopic = "UNKNOWN"
+	EventTopic_TAXICALL     EventTopic = "TAXICALL"
+	EventTopic_NOTIFICATION EventTopic = "NOTIFICATION"
+)
+
+func EventTopicFromString(topicStr string) EventTopic {
+	switch topicStr {
+	case string(EventTopic_TAXICALL):
+		return EventTopic_TAXICALL
+	case string(EventTopic_NOTIFICATION):
+		return EventTopic_NOTIFICATION
+	default:
+		return EventTopic_UNKNOWN
+	}
+}
@@ -0,0 +1,22 @@
+package enum
+
+// Language is ISO 639-1 code of preferred language for notifications
+type Language string
+
+var (
+	// Default language of the service
+	Language_KO Language = "ko"
+	Language_EN Language = "en"
+	Language_ZH Language = "zh"
+)
+
+func LanguageFromString(languageStr string) Language {
+	switch languageStr {
+	case string(Language_EN):
+		return Language_EN
+	case string(Language_ZH):
+		return Language_ZH
+	default:
+		return Language_KO
+	}
+}
@@ -0,0 +1,29 @@
+package enum
+
+type NotificationCategory string
+
+var (
+	// Notifications about taxi call which must be delivered (eg. accepted, failed, done, ticket for drivers)
+	NotificationCategory_TRANSACTIONAL NotificationCategory = "TRANSACTIONAL"
+
+	// Progress of taxi call dispatch for every attempt
+	NotificationCategory_PROGRESS NotificationCategory = "PROGRESS"
+
+	NotificationCategory_MARKETING NotificationCategory = "MARKETING"
+)
+
+type NotificationChannel string
+
+var (
+	NotificationChannel_PUSH NotificationChannel = "PUSH"
+	NotificationChannel_SMS  NotificationChannel = "SMS"
+)
+
+func NotificationChannelFromString(channelStr string) NotificationChannel {
+	switch channelStr {
+	case string(NotificationChannel_SMS):
+		return NotificationChannel_SMS
+	default:
+		return NotificationChannel_PUSH
+	}
+}
@@ -0,0 +1,13 @@
+package enum
+
+type ReferralState string
+
+var (
+	// Waiting for first completed taxi call of referee
+	ReferralState_PENDING ReferralState = "PENDING"
+
+	ReferralState_REWARDED ReferralState = "REWARDED"
+
+	// Rejected by fraud check
+	ReferralState_REJECTED ReferralState = "REJECTED"
+)
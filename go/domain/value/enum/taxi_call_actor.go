@@ -0,0 +1,12 @@
+package enum
+
+type TaxiCallActorType string
+
+var (
+	TaxiCallActorType_USER TaxiCallActorType = "USER"
+
+	TaxiCallActorType_DRIVER TaxiCallActorType = "DRIVER"
+
+	// Taxi call worker (eg. dispatch, failure on price limit)
+	TaxiCallActorType_SYSTEM TaxiCallActorType = "SYSTEM"
+)
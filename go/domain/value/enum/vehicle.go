@@ -0,0 +1,29 @@
+package enum
+
+type VehicleClass string
+
+var (
+	VehicleClass_UNKNOWN VehicleClass = "UNKNOWN"
+
+	// 중형 택시
+	VehicleClass_STANDARD VehicleClass = "STANDARD"
+
+	// 모범 택시
+	VehicleClass_DELUXE VehicleClass = "DELUXE"
+
+	// 대형 택시 (승합)
+	VehicleClass_LARGE VehicleClass = "LARGE"
+)
+
+func VehicleClassFromString(vehicleClassStr string) VehicleClass {
+	switch vehicleClassStr {
+	case string(VehicleClass_STANDARD):
+		return VehicleClass_STANDARD
+	case string(VehicleClass_DELUXE):
+		return VehicleClass_DELUXE
+	case string(VehicleClass_LARGE):
+		return VehicleClass_LARGE
+	default:
+		return VehicleClass_UNKNOWN
+	}
+}
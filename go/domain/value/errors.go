@@ -20,6 +20,7 @@ const (
 	ERR_UNSUPPORTED          ErrCode = "ERR_UNSUPPORTED"
 	ERR_CALL_REQUEST_FAILED  ErrCode = "ERR_CALL_REQUEST_FAILED"
 	ERR_CALL_REQUEST_EXPIRED ErrCode = "ERR_CALL_REQUEST_EXPIRED"
+	ERR_CONFLICT             ErrCode = "ERR_CONFLICT"
 )
 
 type TacoError struct {
@@ -74,4 +75,28 @@ var (
 	ErrActiveTaxiCallRequestExists = TacoError{ERR_ALREADY_EXISTS, "active taxi call exists"}
 
 	ErrAlreadyExpiredCallRequest = TacoError{ERR_CALL_REQUEST_EXPIRED, "taxi call request expired"}
+
+	ErrCouponNotFound = TacoError{ERR_NOTFOUND, "coupon not found"}
+
+	ErrCouponUnavailable = TacoError{ERR_INVALID, "unavailable coupon"}
+
+	ErrInvalidReferralCode = TacoError{ERR_INVALID, "invalid referral code"}
+
+	ErrCorporateNotFound = TacoError{ERR_NOTFOUND, "corporate not found"}
+
+	ErrCorporatePolicyViolation = TacoError{ERR_INVALID, "corporate policy violation"}
+
+	ErrDriverDocumentNotVerified = TacoError{ERR_INVALID, "driver documents are not verified"}
+
+	ErrDriverDocumentAlreadyApproved = TacoError{ERR_INVALID, "driver document is already approved"}
+
+	ErrVehicleNotVerified = TacoError{ERR_INVALID, "vehicle is not verified"}
+
+	ErrVersionConflict = TacoError{ERR_CONFLICT, "concurrently modified"}
+
+	ErrPushTokenUnavailable = TacoError{ERR_INVALID, "push token is not registered or invalid"}
+
+	ErrIdempotencyKeyMismatch = TacoError{ERR_INVALID, "idempotency key is used for another request"}
+
+	ErrIdempotencyKeyInProgress = TacoError{ERR_ALREADY_EXISTS, "request with the idempotency key is in progress"}
 )
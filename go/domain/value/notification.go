@@ -1,5 +1,7 @@
 package value
 
+import "context"
+
 type Notification struct {
 	Principal string
 	Message   NotificationMessage
@@ -10,3 +12,34 @@ type NotificationMessage struct {
 	Title string
 	Body  string
 }
+
+// RealtimeNotification is notification queued to a realtime connection.
+// Connection must ack it with the result of writing it to the client.
+type RealtimeNotification struct {
+	Notification
+	ack chan error
+}
+
+func NewRealtimeNotification(notification Notification) RealtimeNotification {
+	return RealtimeNotification{
+		Notification: notification,
+		ack:          make(chan error, 1),
+	}
+}
+
+func (r RealtimeNotification) Ack(err error) {
+	select {
+	case r.ack <- err:
+	default:
+	}
+}
+
+// Wait returns the result of the write, or error of the context if connection is gone without ack
+func (r RealtimeNotification) Wait(ctx context.Context) error {
+	select {
+	case err := <-r.ack:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
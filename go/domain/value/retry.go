@@ -0,0 +1,29 @@
+package value
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy is exponential backoff with full jitter.
+// Backoff of n-th retry is random duration between 0 and min(MaxDelay, BaseDelay * 2^n)
+type RetryPolicy struct {
+	MaxRetryCount int
+	BaseDelay     time.Duration
+	MaxDelay      time.Duration
+}
+
+func (r RetryPolicy) Backoff(retryCount int) time.Duration {
+	ceil := math.Min(float64(r.MaxDelay), float64(r.BaseDelay)*math.Pow(2, float64(retryCount)))
+	if ceil <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(ceil) + 1))
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetryCount: 3,
+	BaseDelay:     time.Second,
+	MaxDelay:      time.Minute,
+}
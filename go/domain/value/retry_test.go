@@ -0,0 +1,42 @@
+package value
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{
+		MaxRetryCount: 5,
+		BaseDelay:     time.Second,
+		MaxDelay:      10 * time.Second,
+	}
+
+	testCases := []struct {
+		retryCount int
+		ceil       time.Duration
+	}{
+		{0, time.Second},
+		{1, 2 * time.Second},
+		{3, 8 * time.Second},
+		{4, 10 * time.Second},
+		{10, 10 * time.Second},
+	}
+
+	for _, tc := range testCases {
+		for i := 0; i < 100; i++ {
+			backoff := policy.Backoff(tc.retryCount)
+			if backoff < 0 || backoff > tc.ceil {
+				t.Fatalf("backoff of retry %d must be within [0, %v], got %v", tc.retryCount, tc.ceil, backoff)
+			}
+		}
+	}
+}
+
+func TestRetryPolicy_BackoffWithoutDelay(t *testing.T) {
+	policy := RetryPolicy{MaxRetryCount: 3}
+
+	if backoff := policy.Backoff(2); backoff != 0 {
+		t.Errorf("expected no backoff, got %v", backoff)
+	}
+}
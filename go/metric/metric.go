@@ -0,0 +1,92 @@
+package metric
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "taco"
+
+var (
+	HttpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of http requests by server, method, route and status",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"server", "method", "route", "status"})
+
+	DispatchRounds = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "dispatch",
+		Name:      "rounds_total",
+		Help:      "Dispatch rounds by result (dispatched, no_candidate, failed)",
+	}, []string{"result"})
+
+	DispatchCandidates = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "dispatch",
+		Name:      "candidates_per_ticket",
+		Help:      "Number of drivers who received the ticket",
+		Buckets:   []float64{0, 1, 2, 3, 5, 8, 13, 21, 34, 55},
+	})
+
+	TimeToAccept = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "dispatch",
+		Name:      "time_to_accept_seconds",
+		Help:      "Duration from taxi call request to driver's accept",
+		Buckets:   []float64{5, 10, 20, 30, 45, 60, 90, 120, 180, 300, 600},
+	})
+
+	StateTransitions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "taxicall",
+		Name:      "state_transitions_total",
+		Help:      "Taxi call state transitions by from & to state",
+	}, []string{"from", "to"})
+
+	OutboxBacklog = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "outbox",
+		Name:      "backlog_events",
+		Help:      "Events waiting to be published by relay",
+	}, []string{"relay"})
+
+	OutboxPublishDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "outbox",
+		Name:      "publish_duration_seconds",
+		Help:      "Latency of publishing outbox event by relay and result",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"relay", "result"})
+
+	PushSendFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "push",
+		Name:      "send_failures_total",
+		Help:      "Notification send failures by channel (fcm, realtime, sms) and type",
+	}, []string{"channel", "type"})
+
+	ExternalCallDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "external",
+		Name:      "call_duration_seconds",
+		Help:      "Latency of external api calls by service implementation, operation and result",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "operation", "result"})
+)
+
+func Result(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "ok"
+}
+
+// ObserveExternalCall is deferred with pointer of named error so that the result is known on return
+func ObserveExternalCall(service string, operation string, start time.Time, err *error) {
+	ExternalCallDuration.WithLabelValues(service, operation, Result(*err)).Observe(time.Since(start).Seconds())
+}
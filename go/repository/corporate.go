@@ -0,0 +1,253 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/uptrace/bun"
+)
+
+type CorporateRepository interface {
+	GetById(context.Context, bun.IDB, string) (entity.Corporate, error)
+	ListByUserId(context.Context, bun.IDB, string) ([]entity.Corporate, error)
+	Create(context.Context, bun.IDB, entity.Corporate) error
+
+	GetMember(context.Context, bun.IDB, string, string) (entity.CorporateMember, error)
+	ListMembers(context.Context, bun.IDB, string) ([]entity.CorporateMember, error)
+	CreateMember(context.Context, bun.IDB, entity.CorporateMember) error
+	DeleteMember(context.Context, bun.IDB, entity.CorporateMember) error
+
+	GetPolicy(context.Context, bun.IDB, string) (entity.CorporatePolicy, error)
+	UpsertPolicy(context.Context, bun.IDB, entity.CorporatePolicy) error
+
+	ListInvoices(context.Context, bun.IDB, string) ([]entity.CorporateInvoice, error)
+	CreateInvoice(context.Context, bun.IDB, entity.CorporateInvoice) error
+
+	// SummarizeRides returns count and total price of completed taxi calls billed to corporate within [start, end)
+	SummarizeRides(context.Context, bun.IDB, string, time.Time, time.Time) (int, int, error)
+}
+
+type corporateRepository struct{}
+
+func (c corporateRepository) GetById(ctx context.Context, db bun.IDB, corporateId string) (entity.Corporate, error) {
+	resp := entity.Corporate{
+		Id: corporateId,
+	}
+
+	err := db.NewSelect().Model(&resp).WherePK().Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Corporate{}, value.ErrCorporateNotFound
+	}
+	if err != nil {
+		return entity.Corporate{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (c corporateRepository) ListByUserId(ctx context.Context, db bun.IDB, userId string) ([]entity.Corporate, error) {
+	resp := []entity.Corporate{}
+
+	err := db.NewSelect().Model(&resp).
+		Join("JOIN corporate_member AS m ON m.corporate_id = corporate.id").
+		Where("m.user_id = ?", userId).
+		Order("corporate.name").
+		Scan(ctx)
+
+	if err != nil {
+		return []entity.Corporate{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (c corporateRepository) Create(ctx context.Context, db bun.IDB, corporate entity.Corporate) error {
+	res, err := db.NewInsert().Model(&corporate).Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
+	}
+
+	return nil
+}
+
+func (c corporateRepository) GetMember(ctx context.Context, db bun.IDB, corporateId string, userId string) (entity.CorporateMember, error) {
+	resp := entity.CorporateMember{
+		CorporateId: corporateId,
+		UserId:      userId,
+	}
+
+	err := db.NewSelect().Model(&resp).WherePK().Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.CorporateMember{}, value.ErrNotFound
+	}
+	if err != nil {
+		return entity.CorporateMember{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (c corporateRepository) ListMembers(ctx context.Context, db bun.IDB, corporateId string) ([]entity.CorporateMember, error) {
+	resp := []entity.CorporateMember{}
+
+	err := db.NewSelect().Model(&resp).
+		Where("corporate_id = ?", corporateId).
+		Order("create_time").
+		Scan(ctx)
+
+	if err != nil {
+		return []entity.CorporateMember{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (c corporateRepository) CreateMember(ctx context.Context, db bun.IDB, member entity.CorporateMember) error {
+	res, err := db.NewInsert().Model(&member).Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
+	}
+
+	return nil
+}
+
+func (c corporateRepository) DeleteMember(ctx context.Context, db bun.IDB, member entity.CorporateMember) error {
+	res, err := db.NewDelete().Model(&member).WherePK().Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
+	}
+
+	return nil
+}
+
+func (c corporateRepository) GetPolicy(ctx context.Context, db bun.IDB, corporateId string) (entity.CorporatePolicy, error) {
+	resp := entity.CorporatePolicy{
+		CorporateId: corporateId,
+	}
+
+	err := db.NewSelect().Model(&resp).WherePK().Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.CorporatePolicy{}, value.ErrNotFound
+	}
+	if err != nil {
+		return entity.CorporatePolicy{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (c corporateRepository) UpsertPolicy(ctx context.Context, db bun.IDB, policy entity.CorporatePolicy) error {
+	_, err := db.NewInsert().
+		Model(&policy).
+		On("CONFLICT (corporate_id) DO UPDATE").
+		Set("start_hour = EXCLUDED.start_hour").
+		Set("end_hour = EXCLUDED.end_hour").
+		Set("regions = EXCLUDED.regions").
+		Set("max_price_per_ride = EXCLUDED.max_price_per_ride").
+		Set("update_time = EXCLUDED.update_time").
+		Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return nil
+}
+
+func (c corporateRepository) ListInvoices(ctx context.Context, db bun.IDB, corporateId string) ([]entity.CorporateInvoice, error) {
+	resp := []entity.CorporateInvoice{}
+
+	err := db.NewSelect().Model(&resp).
+		Where("corporate_id = ?", corporateId).
+		Order("billing_month DESC").
+		Scan(ctx)
+
+	if err != nil {
+		return []entity.CorporateInvoice{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (c corporateRepository) CreateInvoice(ctx context.Context, db bun.IDB, invoice entity.CorporateInvoice) error {
+	res, err := db.NewInsert().Model(&invoice).
+		On("CONFLICT (corporate_id, billing_month) DO NOTHING").
+		Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: invoice already exists", value.ErrAlreadyExists)
+	}
+
+	return nil
+}
+
+func (c corporateRepository) SummarizeRides(ctx context.Context, db bun.IDB, corporateId string, start time.Time, end time.Time) (int, int, error) {
+	var summary struct {
+		RideCount  int `bun:"ride_count"`
+		TotalPrice int `bun:"total_price"`
+	}
+
+	err := db.NewSelect().
+		Model((*entity.TaxiCallRequest)(nil)).
+		ColumnExpr("count(*) AS ride_count").
+		ColumnExpr("coalesce(sum(base_price + additional_price - coupon_discount_price), 0) AS total_price").
+		Where("corporate_id = ?", corporateId).
+		Where("taxi_call_state = ?", enum.TaxiCallState_DONE).
+		Where("update_time >= ?", start).
+		Where("update_time < ?", end).
+		Scan(ctx, &summary)
+
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return summary.RideCount, summary.TotalPrice, nil
+}
+
+func NewCorporateRepository() corporateRepository {
+	return corporateRepository{}
+}
@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/uptrace/bun"
+)
+
+type CouponRepository interface {
+	GetById(context.Context, bun.IDB, string) (entity.Coupon, error)
+	GetByCode(context.Context, bun.IDB, string) (entity.Coupon, error)
+	ListByUserId(context.Context, bun.IDB, string, time.Time) ([]entity.Coupon, error)
+	Create(context.Context, bun.IDB, entity.Coupon) error
+	IncreaseUsageCount(context.Context, bun.IDB, string) error
+	DecreaseUsageCount(context.Context, bun.IDB, string) error
+
+	GetUsage(context.Context, bun.IDB, string) (entity.CouponUsage, error)
+	CreateUsage(context.Context, bun.IDB, entity.CouponUsage) error
+	UpdateUsage(context.Context, bun.IDB, entity.CouponUsage) error
+	CountUserUsage(context.Context, bun.IDB, string, string) (int, error)
+}
+
+type couponRepository struct{}
+
+func (c couponRepository) GetById(ctx context.Context, db bun.IDB, couponId string) (entity.Coupon, error) {
+	resp := entity.Coupon{
+		Id: couponId,
+	}
+
+	err := db.NewSelect().Model(&resp).WherePK().Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Coupon{}, value.ErrCouponNotFound
+	}
+	if err != nil {
+		return entity.Coupon{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (c couponRepository) GetByCode(ctx context.Context, db bun.IDB, code string) (entity.Coupon, error) {
+	resp := entity.Coupon{}
+
+	err := db.NewSelect().Model(&resp).Where("code = ?", code).Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Coupon{}, value.ErrCouponNotFound
+	}
+	if err != nil {
+		return entity.Coupon{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+// ListByUserId lists not expired coupons issued only for the user
+func (c couponRepository) ListByUserId(ctx context.Context, db bun.IDB, userId string, t time.Time) ([]entity.Coupon, error) {
+	resp := []entity.Coupon{}
+
+	err := db.NewSelect().Model(&resp).
+		Where("user_id = ?", userId).
+		Where("expire_time > ?", t).
+		Order("expire_time").
+		Scan(ctx)
+
+	if err != nil {
+		return []entity.Coupon{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (c couponRepository) Create(ctx context.Context, db bun.IDB, coupon entity.Coupon) error {
+	res, err := db.NewInsert().Model(&coupon).Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
+	}
+
+	return nil
+}
+
+// IncreaseUsageCount increases usage count only if the coupon has remaining usage,
+// so that concurrent reservations can not exceed the usage limit.
+func (c couponRepository) IncreaseUsageCount(ctx context.Context, db bun.IDB, couponId string) error {
+	res, err := db.NewUpdate().
+		Model((*entity.Coupon)(nil)).
+		Set("usage_count = usage_count + 1").
+		Where("id = ?", couponId).
+		Where("usage_limit = 0 OR usage_count < usage_limit").
+		Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return value.ErrCouponUnavailable
+	}
+
+	return nil
+}
+
+func (c couponRepository) DecreaseUsageCount(ctx context.Context, db bun.IDB, couponId string) error {
+	res, err := db.NewUpdate().
+		Model((*entity.Coupon)(nil)).
+		Set("usage_count = usage_count - 1").
+		Where("id = ?", couponId).
+		Where("usage_count > 0").
+		Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
+	}
+
+	return nil
+}
+
+func (c couponRepository) GetUsage(ctx context.Context, db bun.IDB, taxiCallRequestId string) (entity.CouponUsage, error) {
+	resp := entity.CouponUsage{
+		TaxiCallRequestId: taxiCallRequestId,
+	}
+
+	err := db.NewSelect().Model(&resp).WherePK().Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.CouponUsage{}, value.ErrNotFound
+	}
+	if err != nil {
+		return entity.CouponUsage{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (c couponRepository) CreateUsage(ctx context.Context, db bun.IDB, usage entity.CouponUsage) error {
+	res, err := db.NewInsert().Model(&usage).Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
+	}
+
+	return nil
+}
+
+func (c couponRepository) UpdateUsage(ctx context.Context, db bun.IDB, usage entity.CouponUsage) error {
+	res, err := db.NewUpdate().Model(&usage).WherePK().Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
+	}
+
+	return nil
+}
+
+// CountUserUsage counts reserved or used coupon usages of the user
+func (c couponRepository) CountUserUsage(ctx context.Context, db bun.IDB, couponId string, userId string) (int, error) {
+	count, err := db.NewSelect().
+		Model((*entity.CouponUsage)(nil)).
+		Where("coupon_id = ?", couponId).
+		Where("user_id = ?", userId).
+		Where("coupon_usage_state IN (?)", bun.In([]enum.CouponUsageState{
+			enum.CouponUsageState_RESERVED, enum.CouponUsageState_USED,
+		})).
+		Count(ctx)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return count, nil
+}
+
+func NewCouponRepository() couponRepository {
+	return couponRepository{}
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/uptrace/bun"
+)
+
+type DeadLetterRepository interface {
+	List(context.Context, bun.IDB, enum.EventTopic, string, int) ([]entity.DeadLetter, string, error)
+	Get(context.Context, bun.IDB, string) (entity.DeadLetter, error)
+	Create(context.Context, bun.IDB, entity.DeadLetter) error
+	Delete(context.Context, bun.IDB, entity.DeadLetter) error
+}
+
+type deadLetterRepository struct{}
+
+// List returns dead letters of all topics if topic is unknown
+func (d deadLetterRepository) List(ctx context.Context, db bun.IDB, topic enum.EventTopic, pageToken string, count int) ([]entity.DeadLetter, string, error) {
+	resp := []entity.DeadLetter{}
+
+	selectExpr := db.NewSelect().
+		Model(&resp).
+		Order("create_time DESC").
+		Limit(count)
+
+	if topic != enum.EventTopic_UNKNOWN {
+		selectExpr.Where("topic = ?", topic)
+	}
+
+	if pageToken != "" {
+		subQ := db.NewSelect().Model((*entity.DeadLetter)(nil)).Column("create_time").Where("id = ?", pageToken)
+		selectExpr.Where("create_time < (?)", subQ)
+	}
+
+	err := selectExpr.Scan(ctx)
+	if err != nil {
+		return resp, "", fmt.Errorf("%w: error from db: %v", value.ErrDBInternal, err)
+	}
+
+	resultCount := len(resp)
+	if resultCount == 0 {
+		return resp, "", nil
+	}
+	return resp, resp[resultCount-1].Id, nil
+}
+
+func (d deadLetterRepository) Get(ctx context.Context, db bun.IDB, id string) (entity.DeadLetter, error) {
+	resp := entity.DeadLetter{
+		Id: id,
+	}
+
+	err := db.NewSelect().Model(&resp).WherePK().Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.DeadLetter{}, value.ErrNotFound
+	}
+	if err != nil {
+		return entity.DeadLetter{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (d deadLetterRepository) Create(ctx context.Context, db bun.IDB, deadLetter entity.DeadLetter) error {
+	res, err := db.NewInsert().Model(&deadLetter).Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
+	}
+
+	return nil
+}
+
+func (d deadLetterRepository) Delete(ctx context.Context, db bun.IDB, deadLetter entity.DeadLetter) error {
+	res, err := db.NewDelete().Model(&deadLetter).WherePK().Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected == 0 {
+		return value.ErrNotFound
+	}
+
+	return nil
+}
+
+func NewDeadLetterRepository() deadLetterRepository {
+	return deadLetterRepository{}
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/uptrace/bun"
+)
+
+type DemandRepository interface {
+	// ListCellDemands aggregates departures of taxi call requests into grid cells of given size within the bounding box.
+	// Cells with less distinct users than minUserCount are excluded, so that individual riders can not be identified.
+	ListCellDemands(ctx context.Context, db bun.IDB, from time.Time, southWest value.Point, northEast value.Point,
+		cellSize float64, minUserCount int) ([]value.DemandCell, error)
+}
+
+type demandRepository struct{}
+
+func (d demandRepository) ListCellDemands(ctx context.Context, db bun.IDB, from time.Time, southWest value.Point, northEast value.Point,
+	cellSize float64, minUserCount int) ([]value.DemandCell, error) {
+	var rows []struct {
+		LatitudeIndex  int64 `bun:"lat_idx"`
+		LongitudeIndex int64 `bun:"lng_idx"`
+		RequestCount   int   `bun:"request_count"`
+		FailedCount    int   `bun:"failed_count"`
+		ActiveCount    int   `bun:"active_count"`
+	}
+
+	latitudeExpr := "(departure->'point'->>'latitude')::float8"
+	longitudeExpr := "(departure->'point'->>'longitude')::float8"
+
+	err := db.NewSelect().
+		Model((*entity.TaxiCallRequest)(nil)).
+		ColumnExpr("floor("+latitudeExpr+" / ?)::bigint AS lat_idx", cellSize).
+		ColumnExpr("floor("+longitudeExpr+" / ?)::bigint AS lng_idx", cellSize).
+		ColumnExpr("count(*) FILTER (WHERE create_time >= ?) AS request_count", from).
+		ColumnExpr("count(*) FILTER (WHERE create_time >= ? AND taxi_call_state = ?) AS failed_count", from, enum.TaxiCallState_FAILED).
+		ColumnExpr("count(*) FILTER (WHERE taxi_call_state = ?) AS active_count", enum.TaxiCallState_Requested).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("create_time >= ?", from).WhereOr("taxi_call_state = ?", enum.TaxiCallState_Requested)
+		}).
+		Where(latitudeExpr+" BETWEEN ? AND ?", southWest.Latitude, northEast.Latitude).
+		Where(longitudeExpr+" BETWEEN ? AND ?", southWest.Longitude, northEast.Longitude).
+		GroupExpr("lat_idx, lng_idx").
+		Having("count(DISTINCT user_id) >= ?", minUserCount).
+		Scan(ctx, &rows)
+
+	if err != nil {
+		return []value.DemandCell{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	cells := make([]value.DemandCell, 0, len(rows))
+	for _, row := range rows {
+		cells = append(cells, value.DemandCell{
+			Center: value.Point{
+				Latitude:  (float64(row.LatitudeIndex) + 0.5) * cellSize,
+				Longitude: (float64(row.LongitudeIndex) + 0.5) * cellSize,
+			},
+			RequestCount: row.RequestCount,
+			FailedCount:  row.FailedCount,
+			ActiveCount:  row.ActiveCount,
+		})
+	}
+
+	return cells, nil
+}
+
+func NewDemandRepository() demandRepository {
+	return demandRepository{}
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/uptrace/bun"
+)
+
+type DriverDocumentRepository interface {
+	Get(context.Context, bun.IDB, string, enum.DriverDocumentType) (entity.DriverDocument, error)
+	ListByDriverId(context.Context, bun.IDB, string) ([]entity.DriverDocument, error)
+	ListByState(context.Context, bun.IDB, enum.DriverDocumentState, int) ([]entity.DriverDocument, error)
+	Upsert(context.Context, bun.IDB, entity.DriverDocument) error
+	Update(context.Context, bun.IDB, entity.DriverDocument) error
+}
+
+type driverDocumentRepository struct{}
+
+func (d driverDocumentRepository) Get(ctx context.Context, db bun.IDB, driverId string, documentType enum.DriverDocumentType) (entity.DriverDocument, error) {
+	resp := entity.DriverDocument{
+		DriverId:     driverId,
+		DocumentType: documentType,
+	}
+
+	err := db.NewSelect().Model(&resp).WherePK().Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.DriverDocument{}, value.ErrNotFound
+	}
+	if err != nil {
+		return entity.DriverDocument{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (d driverDocumentRepository) ListByDriverId(ctx context.Context, db bun.IDB, driverId string) ([]entity.DriverDocument, error) {
+	resp := []entity.DriverDocument{}
+
+	err := db.NewSelect().Model(&resp).Where("driver_id = ?", driverId).Order("document_type").Scan(ctx)
+
+	if err != nil {
+		return []entity.DriverDocument{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+// ListByState lists documents of the state, oldest updated first
+func (d driverDocumentRepository) ListByState(ctx context.Context, db bun.IDB, state enum.DriverDocumentState, count int) ([]entity.DriverDocument, error) {
+	resp := []entity.DriverDocument{}
+
+	err := db.NewSelect().Model(&resp).
+		Where("driver_document_state = ?", state).
+		Order("update_time").
+		Limit(count).
+		Scan(ctx)
+
+	if err != nil {
+		return []entity.DriverDocument{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+// Upsert creates the document or replaces previously uploaded one of the same type
+func (d driverDocumentRepository) Upsert(ctx context.Context, db bun.IDB, document entity.DriverDocument) error {
+	res, err := db.NewInsert().
+		Model(&document).
+		On("CONFLICT (driver_id, document_type) DO UPDATE").
+		Set("file_key = EXCLUDED.file_key").
+		Set("driver_document_state = EXCLUDED.driver_document_state").
+		Set("reject_reason = EXCLUDED.reject_reason").
+		Set("expire_time = EXCLUDED.expire_time").
+		Set("review_time = EXCLUDED.review_time").
+		Set("update_time = EXCLUDED.update_time").
+		Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
+	}
+
+	return nil
+}
+
+func (d driverDocumentRepository) Update(ctx context.Context, db bun.IDB, document entity.DriverDocument) error {
+	res, err := db.NewUpdate().Model(&document).WherePK().Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
+	}
+
+	return nil
+}
+
+func NewDriverDocumentRepository() driverDocumentRepository {
+	return driverDocumentRepository{}
+}
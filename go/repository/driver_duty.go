@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/uptrace/bun"
+)
+
+type DriverDutySessionRepository interface {
+	GetActiveByDriverId(context.Context, bun.IDB, string) (entity.DriverDutySession, error)
+	// ListByDriverId lists sessions of the driver overlapping with [from, to)
+	ListByDriverId(context.Context, bun.IDB, string, time.Time, time.Time) ([]entity.DriverDutySession, error)
+	// ListStale lists active sessions which are not active since given time
+	ListStale(context.Context, bun.IDB, time.Time, int) ([]entity.DriverDutySession, error)
+	Create(context.Context, bun.IDB, entity.DriverDutySession) error
+	Update(context.Context, bun.IDB, entity.DriverDutySession) error
+	UpdateLastActiveTime(context.Context, bun.IDB, string, time.Time) error
+	// SummarizeRides returns count and total duration of completed taxi calls of the driver within [from, to)
+	SummarizeRides(context.Context, bun.IDB, string, time.Time, time.Time) (int, time.Duration, error)
+}
+
+type driverDutySessionRepository struct{}
+
+func (d driverDutySessionRepository) GetActiveByDriverId(ctx context.Context, db bun.IDB, driverId string) (entity.DriverDutySession, error) {
+	resp := entity.DriverDutySession{}
+
+	err := db.NewSelect().Model(&resp).
+		Where("driver_id = ?", driverId).
+		Where("end_time IS NULL").
+		Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.DriverDutySession{}, value.ErrNotFound
+	}
+	if err != nil {
+		return entity.DriverDutySession{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (d driverDutySessionRepository) ListByDriverId(ctx context.Context, db bun.IDB, driverId string, from time.Time, to time.Time) ([]entity.DriverDutySession, error) {
+	resp := []entity.DriverDutySession{}
+
+	err := db.NewSelect().Model(&resp).
+		Where("driver_id = ?", driverId).
+		Where("start_time < ?", to).
+		Where("end_time IS NULL OR end_time > ?", from).
+		Order("start_time").
+		Scan(ctx)
+
+	if err != nil {
+		return []entity.DriverDutySession{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (d driverDutySessionRepository) ListStale(ctx context.Context, db bun.IDB, activeSince time.Time, count int) ([]entity.DriverDutySession, error) {
+	resp := []entity.DriverDutySession{}
+
+	err := db.NewSelect().Model(&resp).
+		Where("end_time IS NULL").
+		Where("last_active_time < ?", activeSince).
+		Order("last_active_time").
+		Limit(count).
+		Scan(ctx)
+
+	if err != nil {
+		return []entity.DriverDutySession{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (d driverDutySessionRepository) Create(ctx context.Context, db bun.IDB, session entity.DriverDutySession) error {
+	res, err := db.NewInsert().Model(&session).Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
+	}
+
+	return nil
+}
+
+func (d driverDutySessionRepository) Update(ctx context.Context, db bun.IDB, session entity.DriverDutySession) error {
+	res, err := db.NewUpdate().Model(&session).WherePK().Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
+	}
+
+	return nil
+}
+
+func (d driverDutySessionRepository) UpdateLastActiveTime(ctx context.Context, db bun.IDB, driverId string, t time.Time) error {
+	_, err := db.NewUpdate().
+		Model((*entity.DriverDutySession)(nil)).
+		Set("last_active_time = ?", t).
+		Where("driver_id = ?", driverId).
+		Where("end_time IS NULL").
+		Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return nil
+}
+
+func (d driverDutySessionRepository) SummarizeRides(ctx context.Context, db bun.IDB, driverId string, from time.Time, to time.Time) (int, time.Duration, error) {
+	var summary struct {
+		RideCount       int     `bun:"ride_count"`
+		DurationSeconds float64 `bun:"duration_seconds"`
+	}
+
+	err := db.NewSelect().
+		Model((*entity.TaxiCallRequest)(nil)).
+		ColumnExpr("count(*) AS ride_count").
+		ColumnExpr("coalesce(sum(extract(epoch FROM update_time - create_time)), 0) AS duration_seconds").
+		Where("driver_id = ?", driverId).
+		Where("taxi_call_state = ?", enum.TaxiCallState_DONE).
+		Where("update_time >= ?", from).
+		Where("update_time < ?", to).
+		Scan(ctx, &summary)
+
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return summary.RideCount, time.Duration(summary.DurationSeconds * float64(time.Second)), nil
+}
+
+func NewDriverDutySessionRepository() driverDutySessionRepository {
+	return driverDutySessionRepository{}
+}
@@ -6,17 +6,21 @@ import (
 
 	"github.com/taco-labs/taco/go/domain/entity"
 	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/tracing"
 	"github.com/uptrace/bun"
 )
 
 type EventRepository interface {
 	BatchGet(context.Context, bun.IDB, []string, int) ([]entity.Event, error)
 	BatchCommit(context.Context, bun.IDB, []entity.Event) error
+	BatchIncreasePublishFailureCount(context.Context, bun.IDB, []entity.Event) error
 	BatchCreate(context.Context, bun.IDB, []entity.Event) error
+	Count(context.Context, bun.IDB, []string) (int, error)
 }
 
 type eventRepository struct{}
 
+// BatchGet locks returned events until the transaction ends, events locked by other transaction are skipped
 func (e eventRepository) BatchGet(ctx context.Context, db bun.IDB, eventUris []string, maxSize int) ([]entity.Event, error) {
 	resp := []entity.Event{}
 
@@ -24,6 +28,7 @@ func (e eventRepository) BatchGet(ctx context.Context, db bun.IDB, eventUris []s
 		Where("event_uri IN (?)", bun.In(eventUris)).
 		Order("create_time").
 		Limit(maxSize).
+		For("UPDATE SKIP LOCKED").
 		Scan(ctx)
 
 	if err != nil {
@@ -33,6 +38,18 @@ func (e eventRepository) BatchGet(ctx context.Context, db bun.IDB, eventUris []s
 	return resp, nil
 }
 
+func (e eventRepository) Count(ctx context.Context, db bun.IDB, eventUris []string) (int, error) {
+	count, err := db.NewSelect().Model((*entity.Event)(nil)).
+		Where("event_uri IN (?)", bun.In(eventUris)).
+		Count(ctx)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return count, nil
+}
+
 func (e eventRepository) BatchCommit(ctx context.Context, db bun.IDB, events []entity.Event) error {
 	res, err := db.NewDelete().Model(&events).WherePK().Exec(ctx)
 
@@ -51,7 +68,30 @@ func (e eventRepository) BatchCommit(ctx context.Context, db bun.IDB, events []e
 	return nil
 }
 
+func (e eventRepository) BatchIncreasePublishFailureCount(ctx context.Context, db bun.IDB, events []entity.Event) error {
+	messageIds := make([]string, 0, len(events))
+	for _, event := range events {
+		messageIds = append(messageIds, event.MessageId)
+	}
+
+	_, err := db.NewUpdate().Model((*entity.Event)(nil)).
+		Set("publish_failure_count = publish_failure_count + 1").
+		Where("message_id IN (?)", bun.In(messageIds)).
+		Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return nil
+}
+
+// BatchCreate stores trace context of the current span to events, so that the trace continues after relay
 func (e eventRepository) BatchCreate(ctx context.Context, db bun.IDB, events []entity.Event) error {
+	for idx := range events {
+		tracing.InjectEvent(ctx, &events[idx])
+	}
+
 	res, err := db.NewInsert().Model(&events).Exec(ctx)
 
 	if err != nil {
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/uptrace/bun"
+)
+
+type IdempotencyKeyRepository interface {
+	Get(context.Context, bun.IDB, string, string) (entity.IdempotencyKey, error)
+	// Create returns false if the key already exists
+	Create(context.Context, bun.IDB, entity.IdempotencyKey) (bool, error)
+	Update(context.Context, bun.IDB, entity.IdempotencyKey) error
+	// TakeOver renews lease of the abandoned key. Returns false if another request renewed or completed it first
+	TakeOver(context.Context, bun.IDB, entity.IdempotencyKey, time.Time) (bool, error)
+	Delete(context.Context, bun.IDB, string, string) error
+	DeleteExpired(context.Context, bun.IDB, time.Time) (int, error)
+}
+
+type idempotencyKeyRepository struct{}
+
+func (i idempotencyKeyRepository) Get(ctx context.Context, db bun.IDB, principalId string, key string) (entity.IdempotencyKey, error) {
+	resp := entity.IdempotencyKey{
+		PrincipalId: principalId,
+		Key:         key,
+	}
+
+	err := db.NewSelect().Model(&resp).WherePK().Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.IdempotencyKey{}, value.ErrNotFound
+	}
+	if err != nil {
+		return entity.IdempotencyKey{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (i idempotencyKeyRepository) Create(ctx context.Context, db bun.IDB, idempotencyKey entity.IdempotencyKey) (bool, error) {
+	res, err := db.NewInsert().
+		Model(&idempotencyKey).
+		On("CONFLICT (principal_id, idempotency_key) DO NOTHING").
+		Exec(ctx)
+
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return rowsAffected == 1, nil
+}
+
+func (i idempotencyKeyRepository) Update(ctx context.Context, db bun.IDB, idempotencyKey entity.IdempotencyKey) error {
+	res, err := db.NewUpdate().Model(&idempotencyKey).WherePK().Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
+	}
+
+	return nil
+}
+
+func (i idempotencyKeyRepository) TakeOver(ctx context.Context, db bun.IDB, idempotencyKey entity.IdempotencyKey, leaseExpireTime time.Time) (bool, error) {
+	res, err := db.NewUpdate().
+		Model(&idempotencyKey).
+		Set("lease_expire_time = ?", leaseExpireTime).
+		WherePK().
+		Where("response_status = 0").
+		Where("lease_expire_time = ?", idempotencyKey.LeaseExpireTime).
+		Exec(ctx)
+
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return rowsAffected == 1, nil
+}
+
+func (i idempotencyKeyRepository) Delete(ctx context.Context, db bun.IDB, principalId string, key string) error {
+	idempotencyKey := entity.IdempotencyKey{
+		PrincipalId: principalId,
+		Key:         key,
+	}
+
+	_, err := db.NewDelete().Model(&idempotencyKey).WherePK().Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return nil
+}
+
+func (i idempotencyKeyRepository) DeleteExpired(ctx context.Context, db bun.IDB, t time.Time) (int, error) {
+	res, err := db.NewDelete().
+		Model((*entity.IdempotencyKey)(nil)).
+		Where("expire_time <= ?", t).
+		Exec(ctx)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+func NewIdempotencyKeyRepository() idempotencyKeyRepository {
+	return idempotencyKeyRepository{}
+}
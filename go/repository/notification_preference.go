@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/uptrace/bun"
+)
+
+type NotificationPreferenceRepository interface {
+	Get(context.Context, bun.IDB, string) (entity.NotificationPreference, error)
+	Upsert(context.Context, bun.IDB, entity.NotificationPreference) error
+
+	ListMarketingConsents(context.Context, bun.IDB, string) ([]entity.MarketingConsent, error)
+	CreateMarketingConsent(context.Context, bun.IDB, entity.MarketingConsent) error
+}
+
+type notificationPreferenceRepository struct{}
+
+func (n notificationPreferenceRepository) Get(ctx context.Context, db bun.IDB, principalId string) (entity.NotificationPreference, error) {
+	resp := entity.NotificationPreference{
+		PrincipalId: principalId,
+	}
+
+	err := db.NewSelect().Model(&resp).WherePK().Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.NotificationPreference{}, value.ErrNotFound
+	}
+	if err != nil {
+		return entity.NotificationPreference{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (n notificationPreferenceRepository) Upsert(ctx context.Context, db bun.IDB, preference entity.NotificationPreference) error {
+	_, err := db.NewInsert().
+		Model(&preference).
+		On("CONFLICT (principal_id) DO UPDATE").
+		Set("progress_enabled = EXCLUDED.progress_enabled").
+		Set("progress_channel = EXCLUDED.progress_channel").
+		Set("marketing_agreed = EXCLUDED.marketing_agreed").
+		Set("marketing_agree_time = EXCLUDED.marketing_agree_time").
+		Set("marketing_channel = EXCLUDED.marketing_channel").
+		Set("quiet_hours_enabled = EXCLUDED.quiet_hours_enabled").
+		Set("quiet_start_hour = EXCLUDED.quiet_start_hour").
+		Set("quiet_end_hour = EXCLUDED.quiet_end_hour").
+		Set("update_time = EXCLUDED.update_time").
+		Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return nil
+}
+
+func (n notificationPreferenceRepository) ListMarketingConsents(ctx context.Context, db bun.IDB, principalId string) ([]entity.MarketingConsent, error) {
+	resp := []entity.MarketingConsent{}
+
+	err := db.NewSelect().Model(&resp).
+		Where("principal_id = ?", principalId).
+		Order("create_time DESC").
+		Scan(ctx)
+
+	if err != nil {
+		return []entity.MarketingConsent{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (n notificationPreferenceRepository) CreateMarketingConsent(ctx context.Context, db bun.IDB, consent entity.MarketingConsent) error {
+	res, err := db.NewInsert().Model(&consent).Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
+	}
+
+	return nil
+}
+
+func NewNotificationPreferenceRepository() notificationPreferenceRepository {
+	return notificationPreferenceRepository{}
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/domain/value/enum"
+	"github.com/uptrace/bun"
+)
+
+type ReferralRepository interface {
+	GetByRefereeUserId(context.Context, bun.IDB, string) (entity.UserReferral, error)
+	Create(context.Context, bun.IDB, entity.UserReferral) error
+	Update(context.Context, bun.IDB, entity.UserReferral) error
+	GetStats(context.Context, bun.IDB) (entity.ReferralStats, error)
+	GetStatsByReferrerUserId(context.Context, bun.IDB, string) (entity.ReferralStats, error)
+}
+
+type referralRepository struct{}
+
+func (r referralRepository) GetByRefereeUserId(ctx context.Context, db bun.IDB, refereeUserId string) (entity.UserReferral, error) {
+	resp := entity.UserReferral{
+		RefereeUserId: refereeUserId,
+	}
+
+	err := db.NewSelect().Model(&resp).WherePK().Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.UserReferral{}, value.ErrNotFound
+	}
+	if err != nil {
+		return entity.UserReferral{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (r referralRepository) Create(ctx context.Context, db bun.IDB, referral entity.UserReferral) error {
+	res, err := db.NewInsert().Model(&referral).Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
+	}
+
+	return nil
+}
+
+func (r referralRepository) Update(ctx context.Context, db bun.IDB, referral entity.UserReferral) error {
+	res, err := db.NewUpdate().Model(&referral).WherePK().Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
+	}
+
+	return nil
+}
+
+func (r referralRepository) GetStats(ctx context.Context, db bun.IDB) (entity.ReferralStats, error) {
+	return r.getStats(ctx, db.NewSelect())
+}
+
+func (r referralRepository) GetStatsByReferrerUserId(ctx context.Context, db bun.IDB, referrerUserId string) (entity.ReferralStats, error) {
+	return r.getStats(ctx, db.NewSelect().Where("referrer_user_id = ?", referrerUserId))
+}
+
+func (r referralRepository) getStats(ctx context.Context, query *bun.SelectQuery) (entity.ReferralStats, error) {
+	var counts []struct {
+		State enum.ReferralState `bun:"referral_state"`
+		Count int                `bun:"count"`
+	}
+
+	err := query.
+		Model((*entity.UserReferral)(nil)).
+		Column("referral_state").
+		ColumnExpr("count(*) AS count").
+		Group("referral_state").
+		Scan(ctx, &counts)
+
+	if err != nil {
+		return entity.ReferralStats{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	stats := entity.ReferralStats{}
+	for _, c := range counts {
+		stats.Total += c.Count
+		switch c.State {
+		case enum.ReferralState_PENDING:
+			stats.Pending = c.Count
+		case enum.ReferralState_REWARDED:
+			stats.Rewarded = c.Count
+		case enum.ReferralState_REJECTED:
+			stats.Rejected = c.Count
+		}
+	}
+
+	return stats, nil
+}
+
+func NewReferralRepository() referralRepository {
+	return referralRepository{}
+}
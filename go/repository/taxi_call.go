@@ -25,6 +25,7 @@ type TaxiCallRepository interface {
 
 	GetActiveRequestIds(context.Context, bun.IDB) ([]string, error)
 
+	GetTicketById(context.Context, bun.IDB, string) (entity.TaxiCallTicket, error)
 	GetLatestTicketByRequestId(context.Context, bun.IDB, string) (entity.TaxiCallTicket, error)
 	UpsertTicket(context.Context, bun.IDB, entity.TaxiCallTicket) error
 	DeleteTicketByRequestId(context.Context, bun.IDB, string) error
@@ -35,6 +36,7 @@ type TaxiCallRepository interface {
 
 	GetDriverTaxiCallContextWithinRadius(context.Context, bun.IDB,
 		value.Point, int, string, time.Time) ([]entity.DriverTaxiCallContext, error)
+	ListUnansweredDriverTaxiCallContextByTicketId(context.Context, bun.IDB, string) ([]entity.DriverTaxiCallContext, error)
 
 	GetDriverTaxiCallSettlement(context.Context, bun.IDB, string) (entity.DriverTaxiCallSettlement, error)
 	CreateDriverTaxiCallSettlement(context.Context, bun.IDB, entity.DriverTaxiCallSettlement) error
@@ -96,6 +98,23 @@ func (t taxiCallRepository) BulkUpsertDriverTaxiCallContext(ctx context.Context,
 	return nil
 }
 
+func (t taxiCallRepository) GetTicketById(ctx context.Context, db bun.IDB, ticketId string) (entity.TaxiCallTicket, error) {
+	resp := entity.TaxiCallTicket{
+		Id: ticketId,
+	}
+
+	err := db.NewSelect().Model(&resp).WherePK().Scan(ctx)
+
+	if errors.Is(sql.ErrNoRows, err) {
+		return entity.TaxiCallTicket{}, value.ErrNotFound
+	}
+	if err != nil {
+		return entity.TaxiCallTicket{}, fmt.Errorf("%w: error from db: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
 func (t taxiCallRepository) GetLatestTicketByRequestId(ctx context.Context, db bun.IDB, requestId string) (entity.TaxiCallTicket, error) {
 	resp := entity.TaxiCallTicket{}
 
@@ -115,21 +134,36 @@ func (t taxiCallRepository) GetLatestTicketByRequestId(ctx context.Context, db b
 	return resp, nil
 }
 
+// UpsertTicket inserts new ticket or updates existing one only if its version is not changed since read.
+// Returns value.ErrVersionConflict if the ticket is modified concurrently.
 func (t taxiCallRepository) UpsertTicket(ctx context.Context, db bun.IDB, ticket entity.TaxiCallTicket) error {
-	_, err := db.NewInsert().
+	version := ticket.Version
+	ticket.Version += 1
+
+	res, err := db.NewInsert().
 		Model(&ticket).
 		On("CONFLICT (id) DO UPDATE").
 		Set("taxi_call_request_id = EXCLUDED.taxi_call_request_id").
 		Set("attempt = EXCLUDED.attempt").
 		Set("additional_price = EXCLUDED.additional_price").
+		Set("version = EXCLUDED.version").
 		Set("create_time = EXCLUDED.create_time").
 		Set("update_time = EXCLUDED.update_time").
+		Where("taxi_call_ticket.version = ?", version).
 		Exec(ctx)
 
 	if err != nil {
 		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
 	}
 
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: taxi call ticket %s (version %d)", value.ErrVersionConflict, ticket.Id, version)
+	}
+
 	return nil
 }
 
@@ -270,8 +304,17 @@ func (t taxiCallRepository) Create(ctx context.Context, db bun.IDB, taxiCallRequ
 	return nil
 }
 
+// Update updates taxi call request only if its version is not changed since read.
+// Returns value.ErrVersionConflict if the request is modified concurrently.
 func (t taxiCallRepository) Update(ctx context.Context, db bun.IDB, taxiCallRequest entity.TaxiCallRequest) error {
-	res, err := db.NewUpdate().Model(&taxiCallRequest).WherePK().Exec(ctx)
+	version := taxiCallRequest.Version
+	taxiCallRequest.Version += 1
+
+	res, err := db.NewUpdate().
+		Model(&taxiCallRequest).
+		WherePK().
+		Where("version = ?", version).
+		Exec(ctx)
 	if err != nil {
 		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
 	}
@@ -281,7 +324,7 @@ func (t taxiCallRepository) Update(ctx context.Context, db bun.IDB, taxiCallRequ
 		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
 	}
 	if rowsAffected != 1 {
-		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
+		return fmt.Errorf("%w: taxi call request %s (version %d)", value.ErrVersionConflict, taxiCallRequest.Id, version)
 	}
 
 	return nil
@@ -338,6 +381,40 @@ func (t taxiCallRepository) GetDriverTaxiCallContextWithinRadius(ctx context.Con
 	})
 }
 
+// ListUnansweredDriverTaxiCallContextByTicketId returns contexts of drivers who received the ticket but neither accepted nor rejected it
+func (t taxiCallRepository) ListUnansweredDriverTaxiCallContextByTicketId(ctx context.Context, db bun.IDB, ticketId string) ([]entity.DriverTaxiCallContext, error) {
+	type tempModel struct {
+		entity.DriverTaxiCallContext `bun:",extend"`
+
+		EwkbHex string `bun:"location"`
+	}
+
+	var resp []tempModel
+
+	err := db.NewSelect().
+		Model(&resp).
+		ColumnExpr("driver_taxi_call_context.*").
+		ColumnExpr("location").
+		Join("JOIN driver_location").
+		JoinOn("driver_taxi_call_context.driver_id = driver_location.driver_id").
+		Where("can_receive").
+		Where("last_received_request_ticket = ?", ticketId).
+		Where("NOT rejected_last_request_ticket").
+		Scan(ctx)
+
+	if err != nil {
+		return []entity.DriverTaxiCallContext{}, fmt.Errorf("%w: error from db: %v", value.ErrDBInternal, err)
+	}
+
+	return slices.MapErr(resp, func(i tempModel) (entity.DriverTaxiCallContext, error) {
+		if err := i.Location.FromEwkbHex(i.EwkbHex); err != nil {
+			return entity.DriverTaxiCallContext{}, err
+		}
+
+		return i.DriverTaxiCallContext, nil
+	})
+}
+
 func (t taxiCallRepository) GetDriverTaxiCallContext(ctx context.Context, db bun.IDB, driverId string) (entity.DriverTaxiCallContext, error) {
 	// resp := entity.DriverTaxiCallContext{
 	// 	DriverId: driverId,
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/uptrace/bun"
+)
+
+type TaxiCallHistoryRepository interface {
+	ListByRequestId(context.Context, bun.IDB, string) ([]entity.TaxiCallHistory, error)
+	Create(context.Context, bun.IDB, entity.TaxiCallHistory) error
+}
+
+type taxiCallHistoryRepository struct{}
+
+func (t taxiCallHistoryRepository) ListByRequestId(ctx context.Context, db bun.IDB, taxiCallRequestId string) ([]entity.TaxiCallHistory, error) {
+	resp := []entity.TaxiCallHistory{}
+
+	err := db.NewSelect().Model(&resp).
+		Where("taxi_call_request_id = ?", taxiCallRequestId).
+		Order("create_time").
+		Scan(ctx)
+
+	if err != nil {
+		return []entity.TaxiCallHistory{}, fmt.Errorf("%w: error from db: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (t taxiCallHistoryRepository) Create(ctx context.Context, db bun.IDB, history entity.TaxiCallHistory) error {
+	res, err := db.NewInsert().Model(&history).Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: error from db: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
+	}
+
+	return nil
+}
+
+func NewTaxiCallHistoryRepository() taxiCallHistoryRepository {
+	return taxiCallHistoryRepository{}
+}
@@ -14,6 +14,8 @@ import (
 type UserRepository interface {
 	FindById(context.Context, bun.IDB, string) (entity.User, error)
 	FindByUserUniqueKey(context.Context, bun.IDB, string) (entity.User, error)
+	FindByReferralCode(context.Context, bun.IDB, string) (entity.User, error)
+	// CreateUser returns value.ErrAlreadyExists if the referral code is taken by another user
 	CreateUser(context.Context, bun.IDB, entity.User) error
 	UpdateUser(context.Context, bun.IDB, entity.User) error
 	DeleteUser(context.Context, bun.IDB, entity.User) error
@@ -53,8 +55,27 @@ func (u userRepository) FindByUserUniqueKey(ctx context.Context, db bun.IDB, use
 	return user, nil
 }
 
+func (u userRepository) FindByReferralCode(ctx context.Context, db bun.IDB, referralCode string) (entity.User, error) {
+	user := entity.User{}
+
+	err := db.NewSelect().Model(&user).Where("referral_code = ?", referralCode).Scan(ctx)
+
+	if errors.Is(sql.ErrNoRows, err) {
+		return entity.User{}, value.ErrUserNotFound
+	}
+	if err != nil {
+		return entity.User{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return user, nil
+}
+
 func (u userRepository) CreateUser(ctx context.Context, db bun.IDB, user entity.User) error {
-	res, err := db.NewInsert().Model(&user).Exec(ctx)
+	// Conflict is not raised as error, so that transaction is not aborted and caller can retry with another code
+	res, err := db.NewInsert().
+		Model(&user).
+		On("CONFLICT (referral_code) DO NOTHING").
+		Exec(ctx)
 
 	if err != nil {
 		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
@@ -64,6 +85,9 @@ func (u userRepository) CreateUser(ctx context.Context, db bun.IDB, user entity.
 	if err != nil {
 		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
 	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: referral code %s is taken", value.ErrAlreadyExists, user.ReferralCode)
+	}
 	if rowsAffected != 1 {
 		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
 	}
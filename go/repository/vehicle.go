@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/uptrace/bun"
+)
+
+type VehicleRepository interface {
+	GetByDriverId(context.Context, bun.IDB, string) (entity.Vehicle, error)
+	GetByPlateNumber(context.Context, bun.IDB, string) (entity.Vehicle, error)
+	Upsert(context.Context, bun.IDB, entity.Vehicle) error
+	Update(context.Context, bun.IDB, entity.Vehicle) error
+}
+
+type vehicleRepository struct{}
+
+func (v vehicleRepository) GetByDriverId(ctx context.Context, db bun.IDB, driverId string) (entity.Vehicle, error) {
+	resp := entity.Vehicle{
+		DriverId: driverId,
+	}
+
+	err := db.NewSelect().Model(&resp).WherePK().Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Vehicle{}, value.ErrNotFound
+	}
+	if err != nil {
+		return entity.Vehicle{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (v vehicleRepository) GetByPlateNumber(ctx context.Context, db bun.IDB, plateNumber string) (entity.Vehicle, error) {
+	resp := entity.Vehicle{}
+
+	err := db.NewSelect().Model(&resp).Where("plate_number = ?", plateNumber).Scan(ctx)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.Vehicle{}, value.ErrNotFound
+	}
+	if err != nil {
+		return entity.Vehicle{}, fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	return resp, nil
+}
+
+func (v vehicleRepository) Upsert(ctx context.Context, db bun.IDB, vehicle entity.Vehicle) error {
+	res, err := db.NewInsert().
+		Model(&vehicle).
+		On("CONFLICT (driver_id) DO UPDATE").
+		Set("plate_number = EXCLUDED.plate_number").
+		Set("model = EXCLUDED.model").
+		Set("color = EXCLUDED.color").
+		Set("capacity = EXCLUDED.capacity").
+		Set("vehicle_class = EXCLUDED.vehicle_class").
+		Set("verified = EXCLUDED.verified").
+		Set("verify_time = EXCLUDED.verify_time").
+		Set("update_time = EXCLUDED.update_time").
+		Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
+	}
+
+	return nil
+}
+
+func (v vehicleRepository) Update(ctx context.Context, db bun.IDB, vehicle entity.Vehicle) error {
+	res, err := db.NewUpdate().Model(&vehicle).WherePK().Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", value.ErrDBInternal, err)
+	}
+	if rowsAffected != 1 {
+		return fmt.Errorf("%w: invalid rows affected %d", value.ErrDBInternal, rowsAffected)
+	}
+
+	return nil
+}
+
+func NewVehicleRepository() vehicleRepository {
+	return vehicleRepository{}
+}
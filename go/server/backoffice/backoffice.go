@@ -9,12 +9,25 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/taco-labs/taco/go/domain/entity"
 	"github.com/taco-labs/taco/go/domain/request"
+	"github.com/taco-labs/taco/go/server"
+	"github.com/taco-labs/taco/go/utils"
+	"go.uber.org/zap"
 )
 
 type driverApp interface {
 	GetDriver(context.Context, string) (entity.Driver, error)
 	DeleteDriver(context.Context, string) error
 	ActivateDriver(context.Context, string) error
+	ListDriverDocuments(context.Context, string) ([]entity.DriverDocument, error)
+	ListDriverDocumentReviewQueue(context.Context, request.ListDriverDocumentReviewQueueRequest) ([]entity.DriverDocument, error)
+	GetDriverDocumentDownloadUrl(context.Context, string, string) (string, error)
+	ApproveDriverDocument(context.Context, request.ApproveDriverDocumentRequest) (entity.DriverDocument, error)
+	RejectDriverDocument(context.Context, request.RejectDriverDocumentRequest) (entity.DriverDocument, error)
+	GetVehicle(context.Context, string) (entity.Vehicle, error)
+	VerifyVehicle(context.Context, string) (entity.Vehicle, error)
+	ForceOffDuty(context.Context, string) error
+	ListDutySessions(context.Context, request.DriverDutyStatsRequest) ([]entity.DriverDutySession, error)
+	GetDutyStats(context.Context, request.DriverDutyStatsRequest) (entity.DriverDutyStats, error)
 
 	// TODO(taekyeom) Must remove before production
 	DriverToArrival(context.Context, string) error
@@ -27,18 +40,58 @@ type userApp interface {
 	DeleteUser(context.Context, string) error
 }
 
+type couponApp interface {
+	CreateCoupon(context.Context, request.CreateCouponRequest) (entity.Coupon, error)
+	GetCoupon(context.Context, string) (entity.Coupon, error)
+}
+
+type referralApp interface {
+	GetReferralStats(context.Context) (entity.ReferralStats, error)
+	GetUserReferralStats(context.Context, string) (entity.ReferralStats, error)
+}
+
+type corporateApp interface {
+	CreateCorporate(context.Context, request.CreateCorporateRequest) (entity.Corporate, error)
+	GetCorporate(context.Context, string) (entity.Corporate, error)
+	ListMembers(context.Context, string) ([]entity.CorporateMember, error)
+	AddMember(context.Context, string, string) error
+	RemoveMember(context.Context, string, string) error
+	GetPolicy(context.Context, string) (entity.CorporatePolicy, error)
+	UpdatePolicy(context.Context, request.UpdateCorporatePolicyRequest) (entity.CorporatePolicy, error)
+	ListInvoices(context.Context, string) ([]entity.CorporateInvoice, error)
+	GenerateInvoice(context.Context, string, string) (entity.CorporateInvoice, error)
+}
+
+type taxiCallApp interface {
+	ListTaxiCallHistory(context.Context, string) ([]entity.TaxiCallHistory, error)
+}
+
+type deadLetterApp interface {
+	ListDeadLetters(context.Context, request.ListDeadLetterRequest) ([]entity.DeadLetter, string, error)
+	GetDeadLetter(context.Context, string) (entity.DeadLetter, error)
+	ReplayDeadLetter(context.Context, string) error
+	DiscardDeadLetter(context.Context, string) error
+}
+
 type backofficeServer struct {
 	echo     *echo.Echo
 	endpoint string
 	port     int
 	app      struct {
-		driver driverApp
-		user   userApp
+		driver     driverApp
+		user       userApp
+		coupon     couponApp
+		referral   referralApp
+		corporate  corporateApp
+		taxiCall   taxiCallApp
+		deadLetter deadLetterApp
 	}
 	middlewares []echo.MiddlewareFunc
 }
 
 func (b *backofficeServer) initMiddleware() error {
+	b.echo.Use(server.DefaultRequestLoggerMiddleware.Process)
+
 	for _, middleware := range b.middlewares {
 		b.echo.Use(middleware)
 	}
@@ -55,13 +108,53 @@ func (b *backofficeServer) initController() error {
 	driverGroup.GET("/:driverId", b.GetDriver)
 	driverGroup.DELETE("/:driverId", b.DeleteDriver)
 	driverGroup.PUT("/:driverId/activate", b.ActivateDriver)
+	driverGroup.GET("/:driverId/document", b.ListDriverDocuments)
+	driverGroup.GET("/:driverId/document/:documentType/url", b.GetDriverDocumentDownloadUrl)
+	driverGroup.PUT("/:driverId/document/:documentType/approve", b.ApproveDriverDocument)
+	driverGroup.PUT("/:driverId/document/:documentType/reject", b.RejectDriverDocument)
+	driverGroup.GET("/:driverId/vehicle", b.GetVehicle)
+	driverGroup.PUT("/:driverId/vehicle/verify", b.VerifyVehicle)
+	driverGroup.PUT("/:driverId/off_duty", b.ForceOffDuty)
+	driverGroup.GET("/:driverId/duty_session", b.ListDutySessions)
+	driverGroup.GET("/:driverId/duty_stats", b.GetDutyStats)
 	driverGroup.PUT("/:driverId/force_accept/:taxiCallRequestId", b.ForceAcceptTaxiCallRequest)
 	driverGroup.PUT("/:driverId/to_arrival/:taxiCallRequestId", b.DriverToArrival)
 	driverGroup.POST("/:driverId/done/:taxiCallRequestId", b.DoneTaxiCallRequest)
 
+	driverDocumentGroup := b.echo.Group("/driver_document")
+	driverDocumentGroup.GET("/review_queue", b.ListDriverDocumentReviewQueue)
+
 	userGroup := b.echo.Group("/user")
 	userGroup.GET("/:userId", b.GetUser)
 	userGroup.DELETE("/:userId", b.DeleteUser)
+	userGroup.GET("/:userId/referral/stats", b.GetUserReferralStats)
+
+	couponGroup := b.echo.Group("/coupon")
+	couponGroup.POST("", b.CreateCoupon)
+	couponGroup.GET("/:couponId", b.GetCoupon)
+
+	referralGroup := b.echo.Group("/referral")
+	referralGroup.GET("/stats", b.GetReferralStats)
+
+	corporateGroup := b.echo.Group("/corporate")
+	corporateGroup.POST("", b.CreateCorporate)
+	corporateGroup.GET("/:corporateId", b.GetCorporate)
+	corporateGroup.GET("/:corporateId/member", b.ListCorporateMembers)
+	corporateGroup.POST("/:corporateId/member/:userId", b.AddCorporateMember)
+	corporateGroup.DELETE("/:corporateId/member/:userId", b.RemoveCorporateMember)
+	corporateGroup.GET("/:corporateId/policy", b.GetCorporatePolicy)
+	corporateGroup.PUT("/:corporateId/policy", b.UpdateCorporatePolicy)
+	corporateGroup.GET("/:corporateId/invoice", b.ListCorporateInvoices)
+	corporateGroup.POST("/:corporateId/invoice", b.GenerateCorporateInvoice)
+
+	taxiCallGroup := b.echo.Group("/taxicall")
+	taxiCallGroup.GET("/:taxiCallRequestId/history", b.ListTaxiCallHistory)
+
+	deadLetterGroup := b.echo.Group("/dead_letter")
+	deadLetterGroup.GET("", b.ListDeadLetters)
+	deadLetterGroup.GET("/:deadLetterId", b.GetDeadLetter)
+	deadLetterGroup.POST("/:deadLetterId/replay", b.ReplayDeadLetter)
+	deadLetterGroup.DELETE("/:deadLetterId", b.DiscardDeadLetter)
 
 	return nil
 }
@@ -75,6 +168,26 @@ func (b backofficeServer) validate() error {
 		return errors.New("backoffice server need user app")
 	}
 
+	if b.app.coupon == nil {
+		return errors.New("backoffice server need coupon app")
+	}
+
+	if b.app.referral == nil {
+		return errors.New("backoffice server need referral app")
+	}
+
+	if b.app.corporate == nil {
+		return errors.New("backoffice server need corporate app")
+	}
+
+	if b.app.taxiCall == nil {
+		return errors.New("backoffice server need taxi call app")
+	}
+
+	if b.app.deadLetter == nil {
+		return errors.New("backoffice server need dead letter app")
+	}
+
 	return nil
 }
 
@@ -86,7 +199,7 @@ func (b *backofficeServer) Run(ctx context.Context) error {
 }
 
 func (b *backofficeServer) Stop(ctx context.Context) error {
-	fmt.Println("shutting down [Backoffice API] server...")
+	utils.GetLogger(ctx).Info("shutting down server", zap.String("server", "Backoffice API"))
 	return b.echo.Shutdown(ctx)
 }
 
@@ -0,0 +1,141 @@
+package backoffice
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taco-labs/taco/go/domain/request"
+	"github.com/taco-labs/taco/go/domain/response"
+	"github.com/taco-labs/taco/go/server"
+	"github.com/taco-labs/taco/go/utils/slices"
+)
+
+func (b backofficeServer) CreateCorporate(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	req := request.CreateCorporateRequest{}
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	corporate, err := b.app.corporate.CreateCorporate(ctx, req)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.CorporateToResponse(corporate))
+}
+
+func (b backofficeServer) GetCorporate(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	corporateId := e.Param("corporateId")
+
+	corporate, err := b.app.corporate.GetCorporate(ctx, corporateId)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.CorporateToResponse(corporate))
+}
+
+func (b backofficeServer) ListCorporateMembers(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	corporateId := e.Param("corporateId")
+
+	members, err := b.app.corporate.ListMembers(ctx, corporateId)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, slices.Map(members, response.CorporateMemberToResponse))
+}
+
+func (b backofficeServer) AddCorporateMember(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	req := request.CorporateMemberRequest{}
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := b.app.corporate.AddMember(ctx, req.CorporateId, req.UserId); err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, struct{}{})
+}
+
+func (b backofficeServer) RemoveCorporateMember(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	req := request.CorporateMemberRequest{}
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := b.app.corporate.RemoveMember(ctx, req.CorporateId, req.UserId); err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, struct{}{})
+}
+
+func (b backofficeServer) GetCorporatePolicy(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	corporateId := e.Param("corporateId")
+
+	policy, err := b.app.corporate.GetPolicy(ctx, corporateId)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.CorporatePolicyToResponse(policy))
+}
+
+func (b backofficeServer) UpdateCorporatePolicy(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	req := request.UpdateCorporatePolicyRequest{}
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	policy, err := b.app.corporate.UpdatePolicy(ctx, req)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.CorporatePolicyToResponse(policy))
+}
+
+func (b backofficeServer) ListCorporateInvoices(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	corporateId := e.Param("corporateId")
+
+	invoices, err := b.app.corporate.ListInvoices(ctx, corporateId)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, slices.Map(invoices, response.CorporateInvoiceToResponse))
+}
+
+func (b backofficeServer) GenerateCorporateInvoice(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	req := request.GenerateCorporateInvoiceRequest{}
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	invoice, err := b.app.corporate.GenerateInvoice(ctx, req.CorporateId, req.BillingMonth)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.CorporateInvoiceToResponse(invoice))
+}
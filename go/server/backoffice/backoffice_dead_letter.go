@@ -0,0 +1,72 @@
+package backoffice
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taco-labs/taco/go/domain/request"
+	"github.com/taco-labs/taco/go/domain/response"
+	"github.com/taco-labs/taco/go/server"
+	"github.com/taco-labs/taco/go/utils/slices"
+)
+
+func (b backofficeServer) ListDeadLetters(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	req := request.ListDeadLetterRequest{}
+
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	if req.Count == 0 {
+		req.Count = 30
+	}
+
+	deadLetters, pageToken, err := b.app.deadLetter.ListDeadLetters(ctx, req)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.DeadLetterPageResponse{
+		PageToken: pageToken,
+		Data:      slices.Map(deadLetters, response.DeadLetterToResponse),
+	})
+}
+
+func (b backofficeServer) GetDeadLetter(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	deadLetterId := e.Param("deadLetterId")
+
+	deadLetter, err := b.app.deadLetter.GetDeadLetter(ctx, deadLetterId)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.DeadLetterToResponse(deadLetter))
+}
+
+func (b backofficeServer) ReplayDeadLetter(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	deadLetterId := e.Param("deadLetterId")
+
+	if err := b.app.deadLetter.ReplayDeadLetter(ctx, deadLetterId); err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, struct{}{})
+}
+
+func (b backofficeServer) DiscardDeadLetter(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	deadLetterId := e.Param("deadLetterId")
+
+	if err := b.app.deadLetter.DiscardDeadLetter(ctx, deadLetterId); err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, struct{}{})
+}
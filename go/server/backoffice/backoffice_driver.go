@@ -0,0 +1,156 @@
+package backoffice
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taco-labs/taco/go/domain/request"
+	"github.com/taco-labs/taco/go/domain/response"
+	"github.com/taco-labs/taco/go/server"
+	"github.com/taco-labs/taco/go/utils/slices"
+)
+
+func (b backofficeServer) ListDriverDocuments(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	driverId := e.Param("driverId")
+
+	documents, err := b.app.driver.ListDriverDocuments(ctx, driverId)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, slices.Map(documents, response.DriverDocumentToResponse))
+}
+
+func (b backofficeServer) ListDriverDocumentReviewQueue(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	req := request.ListDriverDocumentReviewQueueRequest{}
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	documents, err := b.app.driver.ListDriverDocumentReviewQueue(ctx, req)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, slices.Map(documents, response.DriverDocumentToResponse))
+}
+
+func (b backofficeServer) GetDriverDocumentDownloadUrl(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	driverId := e.Param("driverId")
+	documentType := e.Param("documentType")
+
+	url, err := b.app.driver.GetDriverDocumentDownloadUrl(ctx, driverId, documentType)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.DriverDocumentDownloadUrlResponse{Url: url})
+}
+
+func (b backofficeServer) ApproveDriverDocument(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	req := request.ApproveDriverDocumentRequest{}
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	document, err := b.app.driver.ApproveDriverDocument(ctx, req)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.DriverDocumentToResponse(document))
+}
+
+func (b backofficeServer) RejectDriverDocument(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	req := request.RejectDriverDocumentRequest{}
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	document, err := b.app.driver.RejectDriverDocument(ctx, req)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.DriverDocumentToResponse(document))
+}
+
+func (b backofficeServer) GetVehicle(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	driverId := e.Param("driverId")
+
+	vehicle, err := b.app.driver.GetVehicle(ctx, driverId)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.VehicleToResponse(vehicle))
+}
+
+func (b backofficeServer) VerifyVehicle(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	driverId := e.Param("driverId")
+
+	vehicle, err := b.app.driver.VerifyVehicle(ctx, driverId)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.VehicleToResponse(vehicle))
+}
+
+func (b backofficeServer) ForceOffDuty(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	driverId := e.Param("driverId")
+
+	if err := b.app.driver.ForceOffDuty(ctx, driverId); err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, struct{}{})
+}
+
+func (b backofficeServer) ListDutySessions(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	req := request.DriverDutyStatsRequest{}
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	dutySessions, err := b.app.driver.ListDutySessions(ctx, req)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, slices.Map(dutySessions, response.DriverDutySessionToResponse))
+}
+
+func (b backofficeServer) GetDutyStats(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	req := request.DriverDutyStatsRequest{}
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	stats, err := b.app.driver.GetDutyStats(ctx, req)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.DriverDutyStatsToResponse(stats))
+}
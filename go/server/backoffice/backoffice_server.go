@@ -73,6 +73,59 @@ func (b backofficeServer) DeleteUser(e echo.Context) error {
 	return e.JSON(http.StatusOK, struct{}{})
 }
 
+func (b backofficeServer) CreateCoupon(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	req := request.CreateCouponRequest{}
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	coupon, err := b.app.coupon.CreateCoupon(ctx, req)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.CouponToResponse(coupon))
+}
+
+func (b backofficeServer) GetCoupon(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	couponId := e.Param("couponId")
+
+	coupon, err := b.app.coupon.GetCoupon(ctx, couponId)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.CouponToResponse(coupon))
+}
+
+func (b backofficeServer) GetReferralStats(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	stats, err := b.app.referral.GetReferralStats(ctx)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.ReferralStatsToResponse(stats))
+}
+
+func (b backofficeServer) GetUserReferralStats(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	userId := e.Param("userId")
+
+	stats, err := b.app.referral.GetUserReferralStats(ctx, userId)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.ReferralStatsToResponse(stats))
+}
+
 // TODO (taekyeom) Must remove before production
 func (b backofficeServer) ForceAcceptTaxiCallRequest(e echo.Context) error {
 	ctx := e.Request().Context()
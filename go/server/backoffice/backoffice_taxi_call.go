@@ -0,0 +1,23 @@
+package backoffice
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taco-labs/taco/go/domain/response"
+	"github.com/taco-labs/taco/go/server"
+	"github.com/taco-labs/taco/go/utils/slices"
+)
+
+func (b backofficeServer) ListTaxiCallHistory(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	taxiCallRequestId := e.Param("taxiCallRequestId")
+
+	histories, err := b.app.taxiCall.ListTaxiCallHistory(ctx, taxiCallRequestId)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, slices.Map(histories, response.TaxiCallHistoryToResponse))
+}
@@ -28,6 +28,36 @@ func WithUserApp(userApp userApp) backofficeOption {
 	}
 }
 
+func WithCouponApp(couponApp couponApp) backofficeOption {
+	return func(bs *backofficeServer) {
+		bs.app.coupon = couponApp
+	}
+}
+
+func WithReferralApp(referralApp referralApp) backofficeOption {
+	return func(bs *backofficeServer) {
+		bs.app.referral = referralApp
+	}
+}
+
+func WithCorporateApp(corporateApp corporateApp) backofficeOption {
+	return func(bs *backofficeServer) {
+		bs.app.corporate = corporateApp
+	}
+}
+
+func WithTaxiCallApp(taxiCallApp taxiCallApp) backofficeOption {
+	return func(bs *backofficeServer) {
+		bs.app.taxiCall = taxiCallApp
+	}
+}
+
+func WithDeadLetterApp(deadLetterApp deadLetterApp) backofficeOption {
+	return func(bs *backofficeServer) {
+		bs.app.deadLetter = deadLetterApp
+	}
+}
+
 func WithMiddleware(middleware echo.MiddlewareFunc) backofficeOption {
 	return func(bs *backofficeServer) {
 		bs.middlewares = append(bs.middlewares, middleware)
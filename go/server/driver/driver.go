@@ -7,6 +7,8 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/taco-labs/taco/go/server"
+	"github.com/taco-labs/taco/go/utils"
+	"go.uber.org/zap"
 )
 
 type driverServer struct {
@@ -16,11 +18,13 @@ type driverServer struct {
 	app      struct {
 		driver driverApp
 	}
+	realtime    server.RealtimeSubscriber
 	middlewares []echo.MiddlewareFunc
 }
 
 func (d *driverServer) initMiddleware() error {
 	d.echo.Use(server.DefaultRequestTimeMiddelware.Process)
+	d.echo.Use(server.DefaultRequestLoggerMiddleware.Process)
 
 	for _, middleware := range d.middlewares {
 		d.echo.Use(middleware)
@@ -42,19 +46,32 @@ func (d *driverServer) initController() error {
 	driverGroup.PUT("/:driverId", d.UpdateDriver)
 	driverGroup.PUT("/:driverId/on_duty", d.UpdateOnDuty)
 	driverGroup.PUT("/:driverId/location", d.UpdateDriverLocation)
+	driverGroup.GET("/:driverId/notification_preference", d.GetNotificationPreference)
+	driverGroup.PUT("/:driverId/notification_preference", d.UpdateNotificationPreference)
 	driverGroup.POST("/:driverId/settlement_account", d.RegisterDriverSettlementAccount)
 	driverGroup.GET("/:driverId/settlement_account", d.GetDriverSettlemtnAccount)
 	driverGroup.PUT("/:driverId/settlement_account", d.UpdateDriverSettlemtnAccount)
 	driverGroup.GET("/:driverId/taxicall_latest", d.GetLatestTaxiCallRequest)
 	driverGroup.GET("/:driverId/taxicall", d.ListTaxiCallRequest)
+	driverGroup.GET("/:driverId/document", d.ListDriverDocuments)
+	driverGroup.PUT("/:driverId/document/:documentType", d.UploadDriverDocument)
+	driverGroup.GET("/:driverId/vehicle", d.GetVehicle)
+	driverGroup.PUT("/:driverId/vehicle", d.UpsertVehicle)
+	driverGroup.GET("/:driverId/duty_stats", d.GetDutyStats)
+	driverGroup.GET("/:driverId/demand_heatmap", d.GetDemandHeatmap)
+	if d.realtime != nil {
+		driverGroup.GET("/:driverId/realtime", d.Realtime)
+	}
 
 	taxiCallGroup := d.echo.Group("/taxicall")
 	taxiCallGroup.PUT("/ticket/:ticketId", d.AcceptTaxiCallRequest)
 	taxiCallGroup.DELETE("/ticket/:ticketId", d.RejectTaxiCallRequest)
 
 	// TODO (taekyeom) Proper url pattern..
+	taxiCallGroup.DELETE("/:taxiCallRequestId", d.CancelTaxiCallRequest)
 	taxiCallGroup.PUT("/:taxiCallRequestId/to_arrival", d.DriverToArrival)
 	taxiCallGroup.PUT("/:taxiCallRequestId/done", d.DoneTaxiCallRequest)
+	taxiCallGroup.GET("/:taxiCallRequestId/history", d.ListTaxiCallHistory)
 
 	return nil
 }
@@ -67,7 +84,11 @@ func (d *driverServer) Run(ctx context.Context) error {
 }
 
 func (d driverServer) Stop(ctx context.Context) error {
-	fmt.Println("shutting down [Driver API] server...")
+	utils.GetLogger(ctx).Info("shutting down server", zap.String("server", "Driver API"))
+	// Realtime streams are closed first, since shutdown waits for them
+	if d.realtime != nil {
+		d.realtime.Close()
+	}
 	return d.echo.Shutdown(ctx)
 }
 
@@ -20,6 +20,14 @@ var skipSet = map[string]struct{}{
 	"/healthz":                   {},
 }
 
+// Not yet activated driver can only access to these paths, to submit documents for activation
+var notActivatedAllowSet = map[string]struct{}{
+	"/driver/:driverId":                        {},
+	"/driver/:driverId/document":               {},
+	"/driver/:driverId/document/:documentType": {},
+	"/driver/:driverId/vehicle":                {},
+}
+
 type driverSessionApp interface {
 	GetById(context.Context, string) (entity.DriverSession, error)
 }
@@ -50,7 +58,7 @@ func (s sessionMiddleware) validateSession(key string, c echo.Context) (bool, er
 		return false, err
 	}
 
-	if !session.Activated {
+	if _, ok := notActivatedAllowSet[c.Path()]; !ok && !session.Activated {
 		return false, value.ErrNotYetActivated
 	}
 
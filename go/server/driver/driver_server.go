@@ -8,6 +8,7 @@ import (
 	"github.com/taco-labs/taco/go/domain/entity"
 	"github.com/taco-labs/taco/go/domain/request"
 	"github.com/taco-labs/taco/go/domain/response"
+	"github.com/taco-labs/taco/go/domain/value"
 	"github.com/taco-labs/taco/go/server"
 	"github.com/taco-labs/taco/go/utils/slices"
 )
@@ -31,7 +32,17 @@ type driverApp interface {
 	AcceptTaxiCallRequest(context.Context, string) error
 	RejectTaxiCallRequest(context.Context, string) error
 	DriverToArrival(context.Context, string) error
+	CancelTaxiCallRequest(context.Context, string) error
 	DoneTaxiCallRequest(context.Context, request.DoneTaxiCallRequest) error
+	ListTaxiCallHistory(context.Context, string) ([]entity.TaxiCallHistory, error)
+	GetNotificationPreference(context.Context, string) (entity.NotificationPreference, error)
+	UpdateNotificationPreference(context.Context, request.UpdateNotificationPreferenceRequest) (entity.NotificationPreference, error)
+	UploadDriverDocument(context.Context, request.DriverDocumentUploadRequest) (entity.DriverDocument, error)
+	ListDriverDocuments(context.Context, string) ([]entity.DriverDocument, error)
+	GetVehicle(context.Context, string) (entity.Vehicle, error)
+	UpsertVehicle(context.Context, request.UpsertVehicleRequest) (entity.Vehicle, error)
+	GetDutyStats(context.Context, request.DriverDutyStatsRequest) (entity.DriverDutyStats, error)
+	GetDemandHeatmap(context.Context, request.DemandHeatmapRequest) (value.DemandHeatmap, error)
 }
 
 func (d driverServer) SmsVerificationRequest(e echo.Context) error {
@@ -314,6 +325,32 @@ func (d driverServer) DriverToArrival(e echo.Context) error {
 	return e.JSON(http.StatusOK, struct{}{})
 }
 
+func (d driverServer) CancelTaxiCallRequest(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	taxiCallRequestId := e.Param("taxiCallRequestId")
+
+	err := d.app.driver.CancelTaxiCallRequest(ctx, taxiCallRequestId)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, struct{}{})
+}
+
+func (d driverServer) ListTaxiCallHistory(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	taxiCallRequestId := e.Param("taxiCallRequestId")
+
+	histories, err := d.app.driver.ListTaxiCallHistory(ctx, taxiCallRequestId)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, slices.Map(histories, response.TaxiCallHistoryToResponse))
+}
+
 func (d driverServer) DoneTaxiCallRequest(e echo.Context) error {
 	ctx := e.Request().Context()
 
@@ -330,3 +367,144 @@ func (d driverServer) DoneTaxiCallRequest(e echo.Context) error {
 
 	return e.JSON(http.StatusOK, struct{}{})
 }
+
+func (d driverServer) UploadDriverDocument(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	fileHeader, err := e.FormFile("file")
+	if err != nil {
+		return err
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	req := request.DriverDocumentUploadRequest{
+		DriverId:     e.Param("driverId"),
+		DocumentType: e.Param("documentType"),
+		ContentType:  fileHeader.Header.Get(echo.HeaderContentType),
+		File:         file,
+	}
+
+	document, err := d.app.driver.UploadDriverDocument(ctx, req)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.DriverDocumentToResponse(document))
+}
+
+func (d driverServer) ListDriverDocuments(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	driverId := e.Param("driverId")
+
+	documents, err := d.app.driver.ListDriverDocuments(ctx, driverId)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	resp := slices.Map(documents, response.DriverDocumentToResponse)
+	return e.JSON(http.StatusOK, resp)
+}
+
+func (d driverServer) GetVehicle(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	driverId := e.Param("driverId")
+
+	vehicle, err := d.app.driver.GetVehicle(ctx, driverId)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.VehicleToResponse(vehicle))
+}
+
+func (d driverServer) UpsertVehicle(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	req := request.UpsertVehicleRequest{}
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	vehicle, err := d.app.driver.UpsertVehicle(ctx, req)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.VehicleToResponse(vehicle))
+}
+
+func (d driverServer) GetDutyStats(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	req := request.DriverDutyStatsRequest{}
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	stats, err := d.app.driver.GetDutyStats(ctx, req)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.DriverDutyStatsToResponse(stats))
+}
+
+func (d driverServer) GetDemandHeatmap(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	req := request.DemandHeatmapRequest{}
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	heatmap, err := d.app.driver.GetDemandHeatmap(ctx, req)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, heatmap)
+}
+
+func (d driverServer) Realtime(e echo.Context) error {
+	driverId := e.Param("driverId")
+
+	return server.ServeRealtime(e, d.realtime, driverId)
+}
+
+func (d driverServer) GetNotificationPreference(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	driverId := e.Param("driverId")
+
+	preference, err := d.app.driver.GetNotificationPreference(ctx, driverId)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.NotificationPreferenceToResponse(preference))
+}
+
+func (d driverServer) UpdateNotificationPreference(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	req := request.UpdateNotificationPreferenceRequest{}
+
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+	req.PrincipalId = e.Param("driverId")
+
+	preference, err := d.app.driver.UpdateNotificationPreference(ctx, req)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.NotificationPreferenceToResponse(preference))
+}
@@ -1,6 +1,9 @@
 package driver
 
-import "github.com/labstack/echo/v4"
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/taco-labs/taco/go/server"
+)
 
 type driverServerOption func(*driverServer)
 
@@ -22,6 +25,12 @@ func WithDriverApp(driverApp driverApp) driverServerOption {
 	}
 }
 
+func WithRealtimeSubscriber(subscriber server.RealtimeSubscriber) driverServerOption {
+	return func(ds *driverServer) {
+		ds.realtime = subscriber
+	}
+}
+
 func WithMiddleware(middleware echo.MiddlewareFunc) driverServerOption {
 	return func(ds *driverServer) {
 		ds.middlewares = append(ds.middlewares, middleware)
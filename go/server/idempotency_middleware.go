@@ -0,0 +1,117 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value"
+)
+
+const (
+	HeaderIdempotencyKey      = "Idempotency-Key"
+	HeaderIdempotencyReplayed = "Idempotency-Replayed"
+)
+
+type idempotencyApp interface {
+	Begin(context.Context, string, string, string, string) (entity.IdempotencyKey, bool, error)
+	Complete(context.Context, entity.IdempotencyKey, int, []byte) error
+	Release(context.Context, entity.IdempotencyKey) error
+}
+
+// PrincipalGetter returns id of authenticated principal (user or driver) of the request
+type PrincipalGetter func(context.Context) (string, bool)
+
+// idempotencyMiddleware replays stored response for retried mutating request with the same Idempotency-Key header.
+// Only successful responses are stored; the key is released on failure so that client can retry with it.
+// It must be placed after session middleware to scope keys per principal.
+type idempotencyMiddleware struct {
+	app             idempotencyApp
+	principalGetter PrincipalGetter
+}
+
+func (i idempotencyMiddleware) Process(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		ctx := req.Context()
+
+		key := req.Header.Get(HeaderIdempotencyKey)
+		if key == "" || !isMutatingMethod(req.Method) {
+			return next(c)
+		}
+
+		principalId, ok := i.principalGetter(ctx)
+		if !ok {
+			return next(c)
+		}
+
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		route := fmt.Sprintf("%s %s", req.Method, c.Path())
+		hash := sha256.Sum256(body)
+
+		idempotencyKey, created, err := i.app.Begin(ctx, principalId, key, route, hex.EncodeToString(hash[:]))
+		if err != nil {
+			return ToResponse(err)
+		}
+
+		if !created {
+			if !idempotencyKey.Completed() {
+				return ToResponse(value.ErrIdempotencyKeyInProgress)
+			}
+			c.Response().Header().Set(HeaderIdempotencyReplayed, "true")
+			return c.Blob(idempotencyKey.ResponseStatus, echo.MIMEApplicationJSONCharsetUTF8, idempotencyKey.ResponseBody)
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Response().Writer}
+		c.Response().Writer = recorder
+
+		if err := next(c); err != nil {
+			if releaseErr := i.app.Release(ctx, idempotencyKey); releaseErr != nil {
+				return fmt.Errorf("%w (error while release idempotency key: %v)", err, releaseErr)
+			}
+			return err
+		}
+
+		status := c.Response().Status
+		if status >= http.StatusInternalServerError {
+			return i.app.Release(ctx, idempotencyKey)
+		}
+
+		return i.app.Complete(ctx, idempotencyKey, status, recorder.body.Bytes())
+	}
+}
+
+func NewIdempotencyMiddleware(app idempotencyApp, principalGetter PrincipalGetter) idempotencyMiddleware {
+	return idempotencyMiddleware{
+		app:             app,
+		principalGetter: principalGetter,
+	}
+}
+
+func isMutatingMethod(method string) bool {
+	return method == http.MethodPost ||
+		method == http.MethodPut ||
+		method == http.MethodPatch ||
+		method == http.MethodDelete
+}
+
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taco-labs/taco/go/utils"
+	"go.uber.org/zap"
+)
+
+var (
+	DefaultRequestLoggerMiddleware = requestLoggerMiddleware{}
+)
+
+// requestLoggerMiddleware injects request scoped logger with request id, which is taken from X-Request-Id header
+// or generated, and logs failed request. Principal id is added to the logger by session middleware
+type requestLoggerMiddleware struct{}
+
+func (r requestLoggerMiddleware) Process(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		requestId := c.Request().Header.Get(echo.HeaderXRequestID)
+		if requestId == "" {
+			requestId = utils.MustNewUUID()
+		}
+		c.Response().Header().Set(echo.HeaderXRequestID, requestId)
+
+		ctx := utils.SetRequestId(c.Request().Context(), requestId)
+		c.SetRequest(c.Request().WithContext(ctx))
+
+		err := next(c)
+		if err == nil {
+			return nil
+		}
+
+		status := http.StatusInternalServerError
+		var httpErr *echo.HTTPError
+		if errors.As(err, &httpErr) {
+			status = httpErr.Code
+		}
+
+		// Context of the request is replaced by inner middlewares, so that it has fields like principal id
+		logger := utils.GetLogger(c.Request().Context()).With(
+			zap.String("method", c.Request().Method),
+			zap.String("route", c.Path()),
+			zap.Int("status", status),
+			zap.Error(err),
+		)
+		if status >= http.StatusInternalServerError {
+			logger.Error("request failed")
+		} else {
+			logger.Warn("request failed")
+		}
+
+		return err
+	}
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/taco-labs/taco/go/metric"
+)
+
+// metricMiddleware observes latency & status of the request per echo route.
+// Route pattern (e.g. /taxicall/:taxiCallRequestId) is used instead of raw path to bound label cardinality
+type metricMiddleware struct {
+	serverName string
+}
+
+func (m metricMiddleware) Process(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		start := time.Now()
+
+		err := next(c)
+
+		status := c.Response().Status
+		if err != nil {
+			status = http.StatusInternalServerError
+			var httpErr *echo.HTTPError
+			if errors.As(err, &httpErr) {
+				status = httpErr.Code
+			}
+		}
+
+		route := c.Path()
+		if route == "" {
+			route = "unknown"
+		}
+
+		metric.HttpRequestDuration.
+			WithLabelValues(m.serverName, c.Request().Method, route, strconv.Itoa(status)).
+			Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
+
+func NewMetricMiddleware(serverName string) metricMiddleware {
+	return metricMiddleware{serverName}
+}
+
+type metricServer struct {
+	echo *echo.Echo
+	port int
+}
+
+func (m metricServer) Run(ctx context.Context) error {
+	return m.echo.Start(fmt.Sprintf("0.0.0.0:%d", m.port))
+}
+
+func (m metricServer) Stop(ctx context.Context) error {
+	return m.echo.Shutdown(ctx)
+}
+
+// NewMetricServer serves prometheus metrics on /metrics
+func NewMetricServer(port int) metricServer {
+	e := echo.New()
+	e.HideBanner = true
+	e.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
+
+	return metricServer{
+		echo: e,
+		port: port,
+	}
+}
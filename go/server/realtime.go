@@ -0,0 +1,80 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taco-labs/taco/go/domain/value"
+)
+
+const (
+	realtimeHeartbeatInterval = 15 * time.Second
+	realtimeEventName         = "notification"
+)
+
+type RealtimeSubscriber interface {
+	Subscribe(string) (<-chan value.RealtimeNotification, func())
+	Close()
+}
+
+type realtimeMessage struct {
+	Title string            `json:"title"`
+	Body  string            `json:"body"`
+	Data  map[string]string `json:"data"`
+}
+
+// ServeRealtime streams notifications of the principal as server sent events until the client disconnects
+// or the subscriber is closed. Echo's shutdown doesn't cancel context of streaming request, so that server must close
+// the subscriber before shutdown.
+// Payload is the same as the one sent through FCM.
+func ServeRealtime(c echo.Context, subscriber RealtimeSubscriber, principalId string) error {
+	ctx := c.Request().Context()
+
+	notifications, unsubscribe := subscriber.Subscribe(principalId)
+	defer unsubscribe()
+
+	resp := c.Response()
+	resp.Header().Set(echo.HeaderContentType, "text/event-stream")
+	resp.Header().Set(echo.HeaderCacheControl, "no-cache")
+	resp.Header().Set(echo.HeaderConnection, "keep-alive")
+	resp.WriteHeader(http.StatusOK)
+	resp.Flush()
+
+	ticker := time.NewTicker(realtimeHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := fmt.Fprint(resp, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+			resp.Flush()
+		case notification, ok := <-notifications:
+			if !ok {
+				return nil
+			}
+			payload, err := json.Marshal(realtimeMessage{
+				Title: notification.Message.Title,
+				Body:  notification.Message.Body,
+				Data:  notification.Data,
+			})
+			if err != nil {
+				notification.Ack(err)
+				return err
+			}
+			// Sender regards the notification as delivered only if it is written to the client
+			if _, err := fmt.Fprintf(resp, "event: %s\ndata: %s\n\n", realtimeEventName, payload); err != nil {
+				notification.Ack(err)
+				return nil
+			}
+			resp.Flush()
+			notification.Ack(nil)
+		}
+	}
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/taco-labs/taco/go/domain/value"
-	"github.com/taco-labs/taco/go/utils"
 )
 
 // TODO (taekyeom) Do better error handler
@@ -15,23 +14,13 @@ func ToResponse(err error) error {
 		return err
 	}
 
-	defer utils.Logger.Sync()
-	sugar := utils.Logger.Sugar()
-
+	// Error is logged by request logger middleware with fields of the request
 	tacoError := &value.TacoError{}
 	if !errors.As(err, tacoError) {
-		sugar.Errorw("Non taco error occurred",
-			"error", err.Error(),
-		)
 		return err
 	}
 
 	herr := echo.NewHTTPError(http.StatusInternalServerError)
-	sugar.Errorw("Taco error occurred",
-		"error", err.Error(),
-		"code", tacoError.ErrCode,
-		"message", tacoError.Message,
-	)
 
 	switch tacoError.ErrCode {
 	case value.ERR_UNAUTHENTICATED, value.ERR_UNAUTHORIZED, value.ERR_SESSION_EXPIRED:
@@ -49,6 +38,9 @@ func ToResponse(err error) error {
 	case value.ERR_ALREADY_EXISTS:
 		err := echo.NewHTTPError(http.StatusForbidden, tacoError)
 		herr.SetInternal(err)
+	case value.ERR_CONFLICT:
+		err := echo.NewHTTPError(http.StatusConflict, tacoError)
+		herr.SetInternal(err)
 	case value.ERR_INVALID:
 		err := echo.NewHTTPError(http.StatusForbidden, tacoError)
 		herr.SetInternal(err)
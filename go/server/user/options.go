@@ -1,6 +1,9 @@
 package user
 
-import "github.com/labstack/echo/v4"
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/taco-labs/taco/go/server"
+)
 
 type userServerOption func(us *userServer)
 
@@ -22,6 +25,12 @@ func WithUserApp(userApp UserApp) userServerOption {
 	}
 }
 
+func WithRealtimeSubscriber(subscriber server.RealtimeSubscriber) userServerOption {
+	return func(us *userServer) {
+		us.realtime = subscriber
+	}
+}
+
 func WithMiddleware(middleware echo.MiddlewareFunc) userServerOption {
 	return func(us *userServer) {
 		us.middlewares = append(us.middlewares, middleware)
@@ -7,6 +7,8 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/taco-labs/taco/go/server"
+	"github.com/taco-labs/taco/go/utils"
+	"go.uber.org/zap"
 )
 
 type userServer struct {
@@ -16,11 +18,13 @@ type userServer struct {
 	app      struct {
 		user UserApp
 	}
+	realtime    server.RealtimeSubscriber
 	middlewares []echo.MiddlewareFunc
 }
 
 func (u *userServer) initMiddleware() error {
 	u.echo.Use(server.DefaultRequestTimeMiddelware.Process)
+	u.echo.Use(server.DefaultRequestLoggerMiddleware.Process)
 
 	for _, middleware := range u.middlewares {
 		u.echo.Use(middleware)
@@ -43,6 +47,13 @@ func (u *userServer) initController() error {
 	userGroup.POST("/:userId/payment/:paymentId/default", u.UpdateDefaultPayment)
 	userGroup.GET("/:userId/taxicall_latest", u.GetLatestTaxiCallRequest)
 	userGroup.GET("/:userId/taxicall", u.ListTaxiCallRequest)
+	userGroup.GET("/:userId/coupon", u.ListCoupon)
+	userGroup.GET("/:userId/corporate", u.ListCorporate)
+	userGroup.GET("/:userId/notification_preference", u.GetNotificationPreference)
+	userGroup.PUT("/:userId/notification_preference", u.UpdateNotificationPreference)
+	if u.realtime != nil {
+		userGroup.GET("/:userId/realtime", u.Realtime)
+	}
 
 	paymentGroup := u.echo.Group("/payment")
 	paymentGroup.POST("", u.RegisterCardPayment)
@@ -51,6 +62,7 @@ func (u *userServer) initController() error {
 	taxiCallGroup := u.echo.Group("/taxicall")
 	taxiCallGroup.POST("", u.CreateTaxiCallRequest)
 	taxiCallGroup.DELETE("/:taxiCallRequestId", u.CancelTaxiCallRequest)
+	taxiCallGroup.GET("/:taxiCallRequestId/history", u.ListTaxiCallHistory)
 
 	locationGroup := u.echo.Group("/location")
 	locationGroup.GET("/address", u.GetAddress)
@@ -66,7 +78,11 @@ func (u *userServer) Run(ctx context.Context) error {
 }
 
 func (u *userServer) Stop(ctx context.Context) error {
-	fmt.Println("shutting down [User API] server...")
+	utils.GetLogger(ctx).Info("shutting down server", zap.String("server", "User API"))
+	// Realtime streams are closed first, since shutdown waits for them
+	if u.realtime != nil {
+		u.realtime.Close()
+	}
 	return u.echo.Shutdown(ctx)
 }
 
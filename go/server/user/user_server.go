@@ -27,8 +27,13 @@ type UserApp interface {
 	GetLatestTaxiCallRequest(context.Context, string) (entity.TaxiCallRequest, error)
 	CreateTaxiCallRequest(context.Context, request.CreateTaxiCallRequest) (entity.TaxiCallRequest, error)
 	CancelTaxiCallRequest(context.Context, string) error
+	ListTaxiCallHistory(context.Context, string) ([]entity.TaxiCallHistory, error)
+	GetNotificationPreference(context.Context, string) (entity.NotificationPreference, error)
+	UpdateNotificationPreference(context.Context, request.UpdateNotificationPreferenceRequest) (entity.NotificationPreference, error)
 	SearchLocation(context.Context, request.SearchLocationRequest) ([]value.LocationSummary, error)
 	GetAddress(context.Context, request.GetAddressRequest) (value.Address, error)
+	ListCoupon(context.Context, string) ([]entity.Coupon, error)
+	ListCorporate(context.Context, string) ([]entity.Corporate, error)
 }
 
 func (u userServer) SmsVerificationRequest(e echo.Context) error {
@@ -255,6 +260,19 @@ func (u userServer) CancelTaxiCallRequest(e echo.Context) error {
 	return e.JSON(http.StatusOK, struct{}{})
 }
 
+func (u userServer) ListTaxiCallHistory(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	taxiCallRequestId := e.Param("taxiCallRequestId")
+
+	histories, err := u.app.user.ListTaxiCallHistory(ctx, taxiCallRequestId)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, slices.Map(histories, response.TaxiCallHistoryToResponse))
+}
+
 func (u userServer) SearchLocation(e echo.Context) error {
 	ctx := e.Request().Context()
 
@@ -286,3 +304,70 @@ func (u userServer) GetAddress(e echo.Context) error {
 
 	return e.JSON(http.StatusOK, resp)
 }
+
+func (u userServer) ListCoupon(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	userId := e.Param("userId")
+
+	coupons, err := u.app.user.ListCoupon(ctx, userId)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	resp := slices.Map(coupons, response.CouponToResponse)
+
+	return e.JSON(http.StatusOK, resp)
+}
+
+func (u userServer) ListCorporate(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	userId := e.Param("userId")
+
+	corporates, err := u.app.user.ListCorporate(ctx, userId)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	resp := slices.Map(corporates, response.CorporateToResponse)
+
+	return e.JSON(http.StatusOK, resp)
+}
+
+func (u userServer) Realtime(e echo.Context) error {
+	userId := e.Param("userId")
+
+	return server.ServeRealtime(e, u.realtime, userId)
+}
+
+func (u userServer) GetNotificationPreference(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	userId := e.Param("userId")
+
+	preference, err := u.app.user.GetNotificationPreference(ctx, userId)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.NotificationPreferenceToResponse(preference))
+}
+
+func (u userServer) UpdateNotificationPreference(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	req := request.UpdateNotificationPreferenceRequest{}
+
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+	req.PrincipalId = e.Param("userId")
+
+	preference, err := u.app.user.UpdateNotificationPreference(ctx, req)
+	if err != nil {
+		return server.ToResponse(err)
+	}
+
+	return e.JSON(http.StatusOK, response.NotificationPreferenceToResponse(preference))
+}
@@ -3,11 +3,14 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/taco-labs/taco/go/domain/entity"
 	"github.com/taco-labs/taco/go/domain/request"
 	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/metric"
+	"github.com/taco-labs/taco/go/tracing"
 )
 
 const (
@@ -59,7 +62,9 @@ type tossPaymentTransactionResponse struct {
 	// TODO (taekyeom) Fill...
 }
 
-func (t tossPaymentService) RegisterCard(ctx context.Context, customerKey string, req request.UserPaymentRegisterRequest) (value.CardPaymentInfo, error) {
+func (t tossPaymentService) RegisterCard(ctx context.Context, customerKey string, req request.UserPaymentRegisterRequest) (_ value.CardPaymentInfo, err error) {
+	defer metric.ObserveExternalCall("toss_payments", "RegisterCard", time.Now(), &err)
+
 	tossPaymentRequest := tossPaymentCardRegisterRequest{
 		CustomerKey:            customerKey,
 		CardNumber:             req.CardNumber,
@@ -68,6 +73,7 @@ func (t tossPaymentService) RegisterCard(ctx context.Context, customerKey string
 		CustomerIdentityNumber: req.CustomerIdentityNumber,
 	}
 	resp, err := t.client.R().
+		SetContext(ctx).
 		SetBody(tossPaymentRequest).
 		SetResult(&tossPaymentCardRegisterResponse{}).
 		Post(tossPaymentCardReigstrationPath)
@@ -88,14 +94,17 @@ func (t tossPaymentService) RegisterCard(ctx context.Context, customerKey string
 	}, nil
 }
 
-func (t tossPaymentService) Transaction(ctx context.Context, userPayment entity.UserPayment, payment value.Payment) error {
+func (t tossPaymentService) Transaction(ctx context.Context, userPayment entity.UserPayment, payment value.Payment) (err error) {
+	defer metric.ObserveExternalCall("toss_payments", "Transaction", time.Now(), &err)
+
 	tossPaymentRequest := tossPaymentTransactionRequest{
 		Amount:      payment.Amount,
 		CustomerKey: userPayment.Id,
 		OrderId:     payment.OrderId,
 		OrderName:   payment.OrderName,
 	}
-	_, err := t.client.R().
+	_, err = t.client.R().
+		SetContext(ctx).
 		SetBody(tossPaymentRequest).
 		SetResult(&tossPaymentTransactionResponse{}).
 		Post(fmt.Sprintf(tossPaymentTransactionPath, userPayment.BillingKey))
@@ -110,6 +119,7 @@ func (t tossPaymentService) Transaction(ctx context.Context, userPayment entity.
 
 func NewTossPaymentService(endpoint string, apiKey string) tossPaymentService {
 	client := resty.New().
+		SetTransport(tracing.NewTransport("toss_payments")).
 		SetBaseURL(endpoint).
 		SetAuthScheme("Basic").
 		SetHeader("Content-Type", "application/json").
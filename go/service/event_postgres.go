@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/tracing"
+	"github.com/taco-labs/taco/go/utils"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+// eventQueueMessage is a message of postgres backed event queue.
+// Message is invisible to other subscribers until visible time, which is extended by visibility timeout on receive.
+type eventQueueMessage struct {
+	bun.BaseModel `bun:"table:event_queue"`
+
+	MessageId        string          `bun:"message_id,pk"`
+	Topic            string          `bun:"topic"`
+	EventUri         string          `bun:"event_uri"`
+	Payload          json.RawMessage `bun:"payload,type:jsonb"`
+	RetryCount       int             `bun:"retry_count"`
+	MaxRetryCount    int             `bun:"max_retry_count"`
+	ReceiveCount     int             `bun:"receive_count"`
+	FirstAttemptTime time.Time       `bun:"first_attempt_time,nullzero"`
+	TraceParent      string          `bun:"trace_parent,nullzero"`
+	TraceState       string          `bun:"trace_state,nullzero"`
+	VisibleTime      time.Time       `bun:"visible_time"`
+	CreateTime       time.Time       `bun:"create_time"`
+}
+
+func eventQueueChannel(topic string) string {
+	return fmt.Sprintf("event_queue_%s", topic)
+}
+
+type postgresPubService struct {
+	db    *bun.DB
+	topic string
+}
+
+func (p postgresPubService) SendMessage(ctx context.Context, event entity.Event) error {
+	tracing.InjectEvent(ctx, &event)
+
+	now := time.Now().UTC()
+	message := eventQueueMessage{
+		MessageId:        utils.MustNewUUID(),
+		Topic:            p.topic,
+		EventUri:         event.EventUri,
+		Payload:          event.Payload,
+		RetryCount:       event.RetryCount,
+		MaxRetryCount:    event.MaxRetryCount,
+		ReceiveCount:     0,
+		FirstAttemptTime: event.FirstAttemptTime,
+		TraceParent:      event.TraceParent,
+		TraceState:       event.TraceState,
+		VisibleTime:      now.Add(time.Duration(event.DelaySeconds) * time.Second),
+		CreateTime:       now,
+	}
+
+	if _, err := p.db.NewInsert().Model(&message).Exec(ctx); err != nil {
+		return fmt.Errorf("%w: error while insert event queue message: %v", value.ErrDBInternal, err)
+	}
+
+	// Wake up subscribers, delayed message will be picked up by polling
+	if err := pgdriver.Notify(ctx, p.db, eventQueueChannel(p.topic), message.MessageId); err != nil {
+		return fmt.Errorf("%w: error while notify event queue message: %v", value.ErrDBInternal, err)
+	}
+
+	return nil
+}
+
+func NewPostgresPubService(db *bun.DB, topic string) postgresPubService {
+	return postgresPubService{
+		db:    db,
+		topic: topic,
+	}
+}
+
+type postgresSubService struct {
+	db                *bun.DB
+	topic             string
+	listener          *pgdriver.Listener
+	notifications     <-chan pgdriver.Notification
+	visibilityTimeout time.Duration
+	pollInterval      time.Duration
+}
+
+// GetMessage blocks until a visible message is received or context is done.
+// Message which is not acked within visibility timeout is delivered again.
+func (p postgresSubService) GetMessage(ctx context.Context) (entity.Event, error) {
+	for {
+		message, err := p.receive(ctx)
+		if err != nil && !errors.Is(err, value.ErrNotFound) {
+			return entity.Event{}, err
+		}
+		if err == nil {
+			return p.toEvent(message), nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return entity.Event{}, fmt.Errorf("%w: error from receive message from event queue: %v", value.ErrExternal, ctx.Err())
+		case <-p.notifications:
+		case <-time.After(p.pollInterval):
+		}
+	}
+}
+
+func (p postgresSubService) receive(ctx context.Context) (eventQueueMessage, error) {
+	now := time.Now().UTC()
+	message := eventQueueMessage{}
+
+	candidate := p.db.NewSelect().
+		Model((*eventQueueMessage)(nil)).
+		Column("message_id").
+		Where("topic = ?", p.topic).
+		Where("visible_time <= ?", now).
+		Order("visible_time").
+		Limit(1).
+		For("UPDATE SKIP LOCKED")
+
+	_, err := p.db.NewUpdate().
+		Model((*eventQueueMessage)(nil)).
+		Set("visible_time = ?", now.Add(p.visibilityTimeout)).
+		Set("receive_count = receive_count + 1").
+		Where("message_id = (?)", candidate).
+		Returning("*").
+		Exec(ctx, &message)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return eventQueueMessage{}, value.ErrNotFound
+	}
+	if err != nil {
+		return eventQueueMessage{}, fmt.Errorf("%w: error while receive event queue message: %v", value.ErrDBInternal, err)
+	}
+
+	return message, nil
+}
+
+func (p postgresSubService) toEvent(message eventQueueMessage) entity.Event {
+	event := entity.Event{
+		MessageId:        message.MessageId,
+		EventUri:         message.EventUri,
+		Payload:          message.Payload,
+		CreateTime:       message.CreateTime,
+		RetryCount:       message.RetryCount,
+		MaxRetryCount:    message.MaxRetryCount,
+		FirstAttemptTime: message.FirstAttemptTime,
+		TraceParent:      message.TraceParent,
+		TraceState:       message.TraceState,
+	}
+
+	// Ack of redelivered message's previous receive should not delete it
+	event.SetAck(func() error {
+		_, err := p.db.NewDelete().
+			Model((*eventQueueMessage)(nil)).
+			Where("message_id = ?", message.MessageId).
+			Where("receive_count = ?", message.ReceiveCount).
+			Exec(context.Background())
+		if err != nil {
+			return fmt.Errorf("%w: error while ack event queue message: %v", value.ErrDBInternal, err)
+		}
+		return nil
+	})
+
+	return event
+}
+
+func (p postgresSubService) Close() error {
+	return p.listener.Close()
+}
+
+func NewPostgresSubService(ctx context.Context, db *bun.DB, topic string,
+	visibilityTimeout time.Duration, pollInterval time.Duration) (postgresSubService, error) {
+	listener := pgdriver.NewListener(db)
+	if err := listener.Listen(ctx, eventQueueChannel(topic)); err != nil {
+		listener.Close()
+		return postgresSubService{}, fmt.Errorf("%w: error while listen event queue channel: %v", value.ErrDBInternal, err)
+	}
+
+	return postgresSubService{
+		db:                db,
+		topic:             topic,
+		listener:          listener,
+		notifications:     listener.Channel(),
+		visibilityTimeout: visibilityTimeout,
+		pollInterval:      pollInterval,
+	}, nil
+}
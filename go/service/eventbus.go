@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/taco-labs/taco/go/domain/entity"
 	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/tracing"
 	"gocloud.dev/pubsub"
 )
 
@@ -26,6 +27,7 @@ type sqsPubService struct {
 }
 
 func (s sqsPubService) SendMessage(ctx context.Context, event entity.Event) error {
+	tracing.InjectEvent(ctx, &event)
 	return s.pub.Send(ctx, ToMessage(event))
 }
 
@@ -55,12 +57,25 @@ func NewSqsSubService(sub *pubsub.Subscription) sqsSubService {
 }
 
 func ToMessage(event entity.Event) *pubsub.Message {
+	metadata := map[string]string{
+		entity.MetaDataKey_EventUri:   event.EventUri,
+		entity.MetadataKey_RetryCount: fmt.Sprint(event.RetryCount),
+	}
+	if event.MaxRetryCount > 0 {
+		metadata[entity.MetadataKey_MaxRetryCount] = fmt.Sprint(event.MaxRetryCount)
+	}
+	if !event.FirstAttemptTime.IsZero() {
+		metadata[entity.MetadataKey_FirstAttemptTime] = event.FirstAttemptTime.Format(time.RFC3339Nano)
+	}
+	if event.TraceParent != "" {
+		metadata[entity.MetadataKey_TraceParent] = event.TraceParent
+	}
+	if event.TraceState != "" {
+		metadata[entity.MetadataKey_TraceState] = event.TraceState
+	}
 	message := pubsub.Message{
-		Metadata: map[string]string{
-			entity.MetaDataKey_EventUri:   event.EventUri,
-			entity.MetadataKey_RetryCount: fmt.Sprint(event.RetryCount),
-		},
-		Body: event.Payload,
+		Metadata: metadata,
+		Body:     event.Payload,
 		BeforeSend: func(asFunc func(interface{}) bool) error {
 			req := sqs.SendMessageBatchRequestEntry{}
 			if asFunc(&req) {
@@ -86,6 +101,18 @@ func ToEvent(msg *pubsub.Message) entity.Event {
 		retryCount, _ := strconv.Atoi(retryCount)
 		event.RetryCount = retryCount
 	}
+	maxRetryCount, ok := msg.Metadata[entity.MetadataKey_MaxRetryCount]
+	if ok {
+		maxRetryCount, _ := strconv.Atoi(maxRetryCount)
+		event.MaxRetryCount = maxRetryCount
+	}
+	firstAttemptTime, ok := msg.Metadata[entity.MetadataKey_FirstAttemptTime]
+	if ok {
+		firstAttemptTime, _ := time.Parse(time.RFC3339Nano, firstAttemptTime)
+		event.FirstAttemptTime = firstAttemptTime
+	}
+	event.TraceParent = msg.Metadata[entity.MetadataKey_TraceParent]
+	event.TraceState = msg.Metadata[entity.MetadataKey_TraceState]
 	rawMsg := types.Message{}
 	if msg.As(&rawMsg) {
 		sentTimestampStr := rawMsg.Attributes[string(types.MessageSystemAttributeNameSentTimestamp)]
@@ -2,18 +2,29 @@ package service
 
 import (
 	"context"
-	"os"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/metric"
+	"gocloud.dev/blob"
 )
 
 type FileUploadService interface {
-	Upload(context.Context, os.File) (string, error)
+	Upload(context.Context, string, string, io.Reader) error
+	GetDownloadUrl(context.Context, string) (string, error)
 	Delete(context.Context, string) error
 }
 
 type mockFileUploadService struct{}
 
-func (m mockFileUploadService) Upload(_ context.Context, _ os.File) (string, error) {
-	return "/testurl", nil
+func (m mockFileUploadService) Upload(_ context.Context, _ string, _ string, _ io.Reader) error {
+	return nil
+}
+
+func (m mockFileUploadService) GetDownloadUrl(_ context.Context, key string) (string, error) {
+	return "/testurl/" + key, nil
 }
 
 func (m mockFileUploadService) Delete(_ context.Context, _ string) error {
@@ -23,3 +34,61 @@ func (m mockFileUploadService) Delete(_ context.Context, _ string) error {
 func NewMockFileUploadService() mockFileUploadService {
 	return mockFileUploadService{}
 }
+
+type blobFileUploadService struct {
+	bucket         *blob.Bucket
+	downloadExpire time.Duration
+}
+
+func (b blobFileUploadService) Upload(ctx context.Context, key string, contentType string, r io.Reader) (err error) {
+	defer metric.ObserveExternalCall("blob", "Upload", time.Now(), &err)
+
+	w, err := b.bucket.NewWriter(ctx, key, &blob.WriterOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: error while open writer: %v", value.ErrExternal, err)
+	}
+
+	if _, err := io.Copy(w, r); err != nil {
+		w.Close()
+		return fmt.Errorf("%w: error while write file: %v", value.ErrExternal, err)
+	}
+
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("%w: error while close writer: %v", value.ErrExternal, err)
+	}
+
+	return nil
+}
+
+// GetDownloadUrl returns signed url of the file, which is valid only for a while
+func (b blobFileUploadService) GetDownloadUrl(ctx context.Context, key string) (_ string, err error) {
+	defer metric.ObserveExternalCall("blob", "GetDownloadUrl", time.Now(), &err)
+
+	url, err := b.bucket.SignedURL(ctx, key, &blob.SignedURLOptions{
+		Expiry: b.downloadExpire,
+	})
+	if err != nil {
+		return "", fmt.Errorf("%w: error while get signed url: %v", value.ErrExternal, err)
+	}
+
+	return url, nil
+}
+
+func (b blobFileUploadService) Delete(ctx context.Context, key string) (err error) {
+	defer metric.ObserveExternalCall("blob", "Delete", time.Now(), &err)
+
+	if err := b.bucket.Delete(ctx, key); err != nil {
+		return fmt.Errorf("%w: error while delete file: %v", value.ErrExternal, err)
+	}
+
+	return nil
+}
+
+func NewBlobFileUploadService(bucket *blob.Bucket, downloadExpire time.Duration) blobFileUploadService {
+	return blobFileUploadService{
+		bucket:         bucket,
+		downloadExpire: downloadExpire,
+	}
+}
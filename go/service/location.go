@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/metric"
+	"github.com/taco-labs/taco/go/tracing"
 	"github.com/taco-labs/taco/go/utils/slices"
 )
 
@@ -46,9 +49,12 @@ type kakaoLocationSearchResponse struct {
 	Documents []kakaoLocationSearchDocuments `json:"documents"`
 }
 
-func (k kakaoLocationService) SearchLocation(ctx context.Context, point value.Point, keyword string) ([]value.LocationSummary, error) {
+func (k kakaoLocationService) SearchLocation(ctx context.Context, point value.Point, keyword string) (_ []value.LocationSummary, err error) {
+	defer metric.ObserveExternalCall("kakao_local", "SearchLocation", time.Now(), &err)
+
 	// TODO(taekyeom) to be paginationed
 	resp, err := k.client.R().
+		SetContext(ctx).
 		SetQueryParam("query", keyword).
 		SetQueryParam("x", fmt.Sprint(point.Longitude)).
 		SetQueryParam("y", fmt.Sprint(point.Latitude)).
@@ -81,8 +87,11 @@ type kakaoAddressResponse struct {
 	} `json:"documents"`
 }
 
-func (k kakaoLocationService) GetAddress(ctx context.Context, point value.Point) (value.Address, error) {
+func (k kakaoLocationService) GetAddress(ctx context.Context, point value.Point) (_ value.Address, err error) {
+	defer metric.ObserveExternalCall("kakao_local", "GetAddress", time.Now(), &err)
+
 	resp, err := k.client.R().
+		SetContext(ctx).
 		SetQueryParam("x", fmt.Sprint(point.Longitude)).
 		SetQueryParam("y", fmt.Sprint(point.Latitude)).
 		SetResult(&kakaoAddressResponse{}).
@@ -101,6 +110,7 @@ func (k kakaoLocationService) GetAddress(ctx context.Context, point value.Point)
 
 func NewKakaoLocationService(endpoint string, apiKey string) kakaoLocationService {
 	client := resty.New().
+		SetTransport(tracing.NewTransport("kakao_local")).
 		SetBaseURL(endpoint).
 		SetAuthScheme("KakaoAK").
 		SetAuthToken(apiKey)
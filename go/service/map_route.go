@@ -7,6 +7,8 @@ import (
 
 	"github.com/go-resty/resty/v2"
 	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/metric"
+	"github.com/taco-labs/taco/go/tracing"
 	"github.com/taco-labs/taco/go/utils/slices"
 )
 
@@ -43,8 +45,11 @@ type naverMapsRouteResponse struct {
 	Route   map[string][]*naverMapsRouteUnit `json:"route"`
 }
 
-func (m naverMapsRouteService) GetRoute(ctx context.Context, departure value.Point, arrival value.Point) (value.Route, error) {
+func (m naverMapsRouteService) GetRoute(ctx context.Context, departure value.Point, arrival value.Point) (_ value.Route, err error) {
+	defer metric.ObserveExternalCall("naver_maps", "GetRoute", time.Now(), &err)
+
 	resp, err := m.client.R().
+		SetContext(ctx).
 		SetQueryParam("start", departure.Format()).
 		SetQueryParam("goal", arrival.Format()).
 		SetQueryParam("option", "traoptimal").
@@ -83,6 +88,7 @@ func (m naverMapsRouteService) GetRoute(ctx context.Context, departure value.Poi
 
 func NewNaverMapsRouteService(endpoint string, clientKey string, clientSecret string) naverMapsRouteService {
 	client := resty.New().
+		SetTransport(tracing.NewTransport("naver_maps")).
 		SetBaseURL(endpoint).
 		SetHeader("X-NCP-APIGW-API-KEY-ID", clientKey).
 		SetHeader("X-NCP-APIGW-API-KEY", clientSecret)
@@ -3,49 +3,84 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"firebase.google.com/go/messaging"
 	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/metric"
 	"github.com/taco-labs/taco/go/utils/slices"
 )
 
 type NotificationService interface {
 	SendNotification(context.Context, value.Notification) error
-	BulkSendNotification(context.Context, []value.Notification) error
+	// BulkSendNotification returns error of each notification in the same order, nil for succeeded one.
+	// Notifications which are not sent since the request of their chunk failed have the error of the request,
+	// so that only undelivered ones are retried.
+	BulkSendNotification(context.Context, []value.Notification) ([]error, error)
 }
 
+// Maximum number of messages per fcm batch request
+const fcmBatchSize = 500
+
 type firebaseNotificationService struct {
 	client *messaging.Client
 	dryRun bool
 }
 
-func (f firebaseNotificationService) SendNotification(ctx context.Context, notification value.Notification) error {
+func (f firebaseNotificationService) SendNotification(ctx context.Context, notification value.Notification) (err error) {
+	defer metric.ObserveExternalCall("firebase", "SendNotification", time.Now(), &err)
+
 	fcmMessage := notificationToFcmMessage(notification)
 	// TODO (taekyeom) handle return string?
-	var err error
 	if f.dryRun {
 		_, err = f.client.SendDryRun(ctx, fcmMessage)
 	} else {
 		_, err = f.client.Send(ctx, fcmMessage)
 	}
 	if err != nil {
-		return fmt.Errorf("%w: error while send cloud messaing notification: %v", value.ErrExternal, err)
+		return fmt.Errorf("%w: error while send cloud messaing notification: %v", classifyFcmError(err), err)
 	}
 	return nil
 }
 
-func (f firebaseNotificationService) BulkSendNotification(ctx context.Context, notifications []value.Notification) error {
-	fcmMessages := slices.Map(notifications, notificationToFcmMessage)
-	var err error
-	if f.dryRun {
-		_, err = f.client.SendAllDryRun(ctx, fcmMessages)
-	} else {
-		_, err = f.client.SendAll(ctx, fcmMessages)
-	}
-	if err != nil {
-		return fmt.Errorf("%w: error while bulk send cloud messaing notification: %v", value.ErrExternal, err)
+func (f firebaseNotificationService) BulkSendNotification(ctx context.Context, notifications []value.Notification) ([]error, error) {
+	var requestErr error
+	defer metric.ObserveExternalCall("firebase", "BulkSendNotification", time.Now(), &requestErr)
+
+	results := make([]error, 0, len(notifications))
+
+	for start := 0; start < len(notifications); start += fcmBatchSize {
+		end := start + fcmBatchSize
+		if end > len(notifications) {
+			end = len(notifications)
+		}
+
+		fcmMessages := slices.Map(notifications[start:end], notificationToFcmMessage)
+		var batchResponse *messaging.BatchResponse
+		if f.dryRun {
+			batchResponse, requestErr = f.client.SendAllDryRun(ctx, fcmMessages)
+		} else {
+			batchResponse, requestErr = f.client.SendAll(ctx, fcmMessages)
+		}
+		// Previous chunks are already delivered, the rest is reported as failed without being sent
+		if requestErr != nil {
+			err := fmt.Errorf("%w: error while bulk send cloud messaing notification: %v", value.ErrExternal, requestErr)
+			for len(results) < len(notifications) {
+				results = append(results, err)
+			}
+			break
+		}
+
+		for _, resp := range batchResponse.Responses {
+			if resp.Success {
+				results = append(results, nil)
+				continue
+			}
+			results = append(results, fmt.Errorf("%w: error while send cloud messaing notification: %v", classifyFcmError(resp.Error), resp.Error))
+		}
 	}
-	return nil
+
+	return results, nil
 }
 
 func NewFirebaseNotificationService(client *messaging.Client, dryRun bool) firebaseNotificationService {
@@ -55,6 +90,16 @@ func NewFirebaseNotificationService(client *messaging.Client, dryRun bool) fireb
 	}
 }
 
+// classifyFcmError returns value.ErrPushTokenUnavailable if the token is not valid anymore, so that caller can clean it up.
+// Invalid argument is not regarded as dead token since malformed or oversized message also causes it.
+// Otherwise the error is regarded as transient.
+func classifyFcmError(err error) error {
+	if messaging.IsRegistrationTokenNotRegistered(err) {
+		return value.ErrPushTokenUnavailable
+	}
+	return value.ErrExternal
+}
+
 func notificationToFcmMessage(notification value.Notification) *messaging.Message {
 	message := messaging.Message{
 		Token: notification.Principal,
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/value"
+)
+
+// Connection which doesn't write the notification within the timeout is regarded as gone
+const realtimeAckTimeout = 3 * time.Second
+
+type RealtimeService interface {
+	// Send delivers notification to connected clients of the principal, and waits until one of them writes it to the client.
+	// Returns false if no connection wrote it, so that caller can fallback to other channel.
+	Send(context.Context, string, value.Notification) (bool, error)
+	// Subscribe registers connection of the principal. Returned function must be called when the connection is closed.
+	Subscribe(string) (<-chan value.RealtimeNotification, func())
+	// Close closes channels of all connections so that streaming handlers return on shutdown
+	Close()
+}
+
+// inMemoryRealtimeService delivers notifications to clients connected to the same process.
+// Clients connected to another replica are considered as offline.
+type inMemoryRealtimeService struct {
+	mu          *sync.RWMutex
+	subscribers map[string]map[chan value.RealtimeNotification]struct{}
+	closed      chan struct{}
+	closeOnce   *sync.Once
+	bufferSize  int
+}
+
+func (i inMemoryRealtimeService) Send(ctx context.Context, principalId string, notification value.Notification) (bool, error) {
+	pending := i.enqueue(principalId, notification)
+	if len(pending) == 0 {
+		return false, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, realtimeAckTimeout)
+	defer cancel()
+
+	for _, realtimeNotification := range pending {
+		if err := realtimeNotification.Wait(ctx); err == nil {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// enqueue returns notifications queued to the connections, ack is waited without lock so that connections can be closed meanwhile
+func (i inMemoryRealtimeService) enqueue(principalId string, notification value.Notification) []value.RealtimeNotification {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	pending := []value.RealtimeNotification{}
+	for ch := range i.subscribers[principalId] {
+		realtimeNotification := value.NewRealtimeNotification(notification)
+		select {
+		case ch <- realtimeNotification:
+			pending = append(pending, realtimeNotification)
+		default:
+			// Slow consumer, skip it
+		}
+	}
+
+	return pending
+}
+
+func (i inMemoryRealtimeService) Subscribe(principalId string) (<-chan value.RealtimeNotification, func()) {
+	ch := make(chan value.RealtimeNotification, i.bufferSize)
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	select {
+	case <-i.closed:
+		close(ch)
+		return ch, func() {}
+	default:
+	}
+
+	if _, ok := i.subscribers[principalId]; !ok {
+		i.subscribers[principalId] = make(map[chan value.RealtimeNotification]struct{})
+	}
+	i.subscribers[principalId][ch] = struct{}{}
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			i.mu.Lock()
+			defer i.mu.Unlock()
+
+			// Channel is already closed if the service is closed
+			if _, ok := i.subscribers[principalId][ch]; !ok {
+				return
+			}
+			delete(i.subscribers[principalId], ch)
+			if len(i.subscribers[principalId]) == 0 {
+				delete(i.subscribers, principalId)
+			}
+			close(ch)
+		})
+	}
+
+	return ch, unsubscribe
+}
+
+func (i inMemoryRealtimeService) Close() {
+	i.closeOnce.Do(func() {
+		i.mu.Lock()
+		defer i.mu.Unlock()
+
+		close(i.closed)
+		for principalId, channels := range i.subscribers {
+			for ch := range channels {
+				close(ch)
+			}
+			delete(i.subscribers, principalId)
+		}
+	})
+}
+
+func NewInMemoryRealtimeService(bufferSize int) inMemoryRealtimeService {
+	return inMemoryRealtimeService{
+		mu:          &sync.RWMutex{},
+		subscribers: make(map[string]map[chan value.RealtimeNotification]struct{}),
+		closed:      make(chan struct{}),
+		closeOnce:   &sync.Once{},
+		bufferSize:  bufferSize,
+	}
+}
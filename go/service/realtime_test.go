@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/taco-labs/taco/go/domain/value"
+)
+
+func TestInMemoryRealtimeService_Send(t *testing.T) {
+	notification := value.Notification{Message: value.NotificationMessage{Title: "title"}}
+
+	t.Run("not connected", func(t *testing.T) {
+		realtime := NewInMemoryRealtimeService(1)
+
+		delivered, err := realtime.Send(context.Background(), "principal", notification)
+		if err != nil || delivered {
+			t.Errorf("expected not delivered, got %v, %v", delivered, err)
+		}
+	})
+
+	t.Run("written to the client", func(t *testing.T) {
+		realtime := NewInMemoryRealtimeService(1)
+		notifications, unsubscribe := realtime.Subscribe("principal")
+		defer unsubscribe()
+
+		go func() {
+			(<-notifications).Ack(nil)
+		}()
+
+		delivered, err := realtime.Send(context.Background(), "principal", notification)
+		if err != nil || !delivered {
+			t.Errorf("expected delivered, got %v, %v", delivered, err)
+		}
+	})
+
+	t.Run("failed to write to the client", func(t *testing.T) {
+		realtime := NewInMemoryRealtimeService(1)
+		notifications, unsubscribe := realtime.Subscribe("principal")
+		defer unsubscribe()
+
+		go func() {
+			(<-notifications).Ack(errors.New("broken pipe"))
+		}()
+
+		delivered, err := realtime.Send(context.Background(), "principal", notification)
+		if err != nil || delivered {
+			t.Errorf("expected not delivered, got %v, %v", delivered, err)
+		}
+	})
+
+	t.Run("connection gone without ack", func(t *testing.T) {
+		realtime := NewInMemoryRealtimeService(1)
+		_, unsubscribe := realtime.Subscribe("principal")
+		defer unsubscribe()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		delivered, err := realtime.Send(ctx, "principal", notification)
+		if err != nil || delivered {
+			t.Errorf("expected not delivered, got %v, %v", delivered, err)
+		}
+	})
+}
+
+func TestInMemoryRealtimeService_Close(t *testing.T) {
+	realtime := NewInMemoryRealtimeService(1)
+	notifications, unsubscribe := realtime.Subscribe("principal")
+
+	realtime.Close()
+	realtime.Close()
+
+	if _, ok := <-notifications; ok {
+		t.Error("expected channel to be closed")
+	}
+	// Unsubscribe after close must not close the channel again
+	unsubscribe()
+
+	closed, _ := realtime.Subscribe("principal")
+	if _, ok := <-closed; ok {
+		t.Error("expected subscription after close to be closed")
+	}
+}
@@ -3,9 +3,11 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/coolsms/coolsms-go"
 	"github.com/taco-labs/taco/go/domain/value"
+	"github.com/taco-labs/taco/go/metric"
 )
 
 type SmsSenderService interface {
@@ -27,7 +29,9 @@ type coolSmsSenderService struct {
 	client    *coolsms.Client
 }
 
-func (s coolSmsSenderService) SendSms(ctx context.Context, phone string, message string) error {
+func (s coolSmsSenderService) SendSms(ctx context.Context, phone string, message string) (err error) {
+	defer metric.ObserveExternalCall("coolsms", "SendSms", time.Now(), &err)
+
 	msg := make(map[string]interface{})
 
 	msg["to"] = phone
@@ -38,7 +42,7 @@ func (s coolSmsSenderService) SendSms(ctx context.Context, phone string, message
 	params := make(map[string]interface{})
 	params["message"] = msg
 
-	_, err := s.client.Messages.SendSimpleMessage(params)
+	_, err = s.client.Messages.SendSimpleMessage(params)
 	if err != nil {
 		return fmt.Errorf("%w: %v", value.ErrExternal, err)
 	}
@@ -0,0 +1,57 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/taco-labs/taco/go/domain/entity"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InjectEvent sets trace context of the current span to the event.
+// Trace context of the event is kept as is if there is no span in the context (e.g. retried without tracing)
+func InjectEvent(ctx context.Context, event *entity.Event) {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+
+	if traceParent := carrier.Get(entity.MetadataKey_TraceParent); traceParent != "" {
+		event.TraceParent = traceParent
+		event.TraceState = carrier.Get(entity.MetadataKey_TraceState)
+	}
+}
+
+// ExtractEvent returns context which has remote span of the event's producer as parent
+func ExtractEvent(ctx context.Context, event entity.Event) context.Context {
+	if event.TraceParent == "" {
+		return ctx
+	}
+
+	carrier := propagation.MapCarrier{
+		entity.MetadataKey_TraceParent: event.TraceParent,
+		entity.MetadataKey_TraceState:  event.TraceState,
+	}
+	return propagator.Extract(ctx, carrier)
+}
+
+// StartConsumer starts consumer span of the event as a child of the producer's span
+func StartConsumer(ctx context.Context, name string, event entity.Event) (context.Context, trace.Span) {
+	return Start(ExtractEvent(ctx, event), name,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			eventUriKey.String(event.EventUri),
+			eventIdKey.String(event.MessageId),
+			retryCountKey.Int(event.RetryCount),
+		),
+	)
+}
+
+// StartProducer starts producer span of the event as a child of the span which created the event
+func StartProducer(ctx context.Context, name string, event entity.Event) (context.Context, trace.Span) {
+	return Start(ExtractEvent(ctx, event), name,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			eventUriKey.String(event.EventUri),
+			eventIdKey.String(event.MessageId),
+		),
+	)
+}
@@ -0,0 +1,76 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	eventUriKey   = attribute.Key("taco.event.uri")
+	eventIdKey    = attribute.Key("taco.event.id")
+	retryCountKey = attribute.Key("taco.event.retry_count")
+)
+
+// queryHook traces bun queries. Query without parent span (e.g. polling of outbox & event queue) is not traced,
+// and query template is used as statement so that arguments are not exported
+type queryHook struct{}
+
+type querySpanKey struct{}
+
+func (q queryHook) BeforeQuery(ctx context.Context, event *bun.QueryEvent) context.Context {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+
+	ctx, span := Start(ctx, fmt.Sprintf("db %s", event.Operation()),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperationKey.String(event.Operation()),
+			semconv.DBStatementKey.String(event.QueryTemplate),
+		),
+	)
+
+	if event.Stash == nil {
+		event.Stash = make(map[interface{}]interface{})
+	}
+	event.Stash[querySpanKey{}] = span
+
+	return ctx
+}
+
+func (q queryHook) AfterQuery(ctx context.Context, event *bun.QueryEvent) {
+	// Context may have parent span only, which must not be ended here
+	span, ok := event.Stash[querySpanKey{}].(trace.Span)
+	if !ok {
+		return
+	}
+
+	err := event.Err
+	if errors.Is(err, sql.ErrNoRows) {
+		err = nil
+	}
+	End(span, err)
+}
+
+func NewQueryHook() queryHook {
+	return queryHook{}
+}
+
+// NewTransport returns http transport which traces requests to external api of the service
+func NewTransport(service string) http.RoundTripper {
+	return otelhttp.NewTransport(http.DefaultTransport,
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return fmt.Sprintf("%s %s", service, r.Method)
+		}),
+	)
+}
@@ -3,9 +3,12 @@ package utils
 import (
 	"context"
 	"time"
+
+	"go.uber.org/zap"
 )
 
 type requestTimeKey struct{}
+type requestIdKey struct{}
 type userIdKey struct{}
 type driverIdKey struct{}
 
@@ -25,7 +28,20 @@ func GetRequestTimeOrNow(ctx context.Context) time.Time {
 	return v
 }
 
+// SetRequestId sets request id and adds it to the logger of the context
+func SetRequestId(ctx context.Context, requestId string) context.Context {
+	ctx = WithLogFields(ctx, zap.String(LogKey_RequestId, requestId))
+	return context.WithValue(ctx, requestIdKey{}, requestId)
+}
+
+// LookupRequestId returns false if request id is not set, eg. background works
+func LookupRequestId(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(requestIdKey{}).(string)
+	return v, ok
+}
+
 func SetUserId(ctx context.Context, userId string) context.Context {
+	ctx = WithLogFields(ctx, zap.String(LogKey_PrincipalId, userId))
 	return context.WithValue(ctx, userIdKey{}, userId)
 }
 
@@ -33,10 +49,33 @@ func GetUserId(ctx context.Context) string {
 	return ctx.Value(userIdKey{}).(string)
 }
 
+// LookupUserId returns false if user id is not set, eg. request without user session
+func LookupUserId(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(userIdKey{}).(string)
+	return v, ok
+}
+
 func SetDriverId(ctx context.Context, driverId string) context.Context {
+	ctx = WithLogFields(ctx, zap.String(LogKey_PrincipalId, driverId))
 	return context.WithValue(ctx, driverIdKey{}, driverId)
 }
 
 func GetDriverId(ctx context.Context) string {
 	return ctx.Value(driverIdKey{}).(string)
 }
+
+// LookupDriverId returns false if driver id is not set, eg. request without driver session
+func LookupDriverId(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(driverIdKey{}).(string)
+	return v, ok
+}
+
+// SetTaxiCallRequestId adds taxi call request id to the logger of the context
+func SetTaxiCallRequestId(ctx context.Context, taxiCallRequestId string) context.Context {
+	return WithLogFields(ctx, zap.String(LogKey_TaxiCallRequestId, taxiCallRequestId))
+}
+
+// SetEvent adds id & uri of the event being handled to the logger of the context
+func SetEvent(ctx context.Context, eventId string, eventUri string) context.Context {
+	return WithLogFields(ctx, zap.String(LogKey_EventId, eventId), zap.String(LogKey_EventUri, eventUri))
+}
@@ -1,9 +1,73 @@
 package utils
 
-import "go.uber.org/zap"
+import (
+	"context"
+	"fmt"
 
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	LogKey_RequestId         = "request_id"
+	LogKey_PrincipalId       = "principal_id"
+	LogKey_TaxiCallRequestId = "taxi_call_request_id"
+	LogKey_EventId           = "event_id"
+	LogKey_EventUri          = "event_uri"
+)
+
+const (
+	LogFormat_JSON    = "json"
+	LogFormat_CONSOLE = "console"
+)
+
+// Logger is the base logger, which is used when context doesn't carry a logger (e.g. background works)
 var Logger *zap.Logger
 
 func init() {
 	Logger, _ = zap.NewProduction()
 }
+
+// InitLogger replaces base logger with given level (debug, info, warn, error) and format (json, console)
+func InitLogger(level string, format string) error {
+	logLevel, err := zap.ParseAtomicLevel(level)
+	if err != nil {
+		return fmt.Errorf("invalid log level: %w", err)
+	}
+
+	var conf zap.Config
+	switch format {
+	case LogFormat_JSON:
+		conf = zap.NewProductionConfig()
+	case LogFormat_CONSOLE:
+		conf = zap.NewDevelopmentConfig()
+		conf.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	default:
+		return fmt.Errorf("invalid log format: %s", format)
+	}
+	conf.Level = logLevel
+
+	logger, err := conf.Build()
+	if err != nil {
+		return fmt.Errorf("error while build logger: %w", err)
+	}
+	Logger = logger
+
+	return nil
+}
+
+type loggerKey struct{}
+
+// GetLogger returns logger carried by the context, which has fields of the request or event being handled
+func GetLogger(ctx context.Context) *zap.Logger {
+	v, ok := ctx.Value(loggerKey{}).(*zap.Logger)
+	if !ok {
+		return Logger
+	}
+	return v
+}
+
+// WithLogFields returns context which carries logger with given fields in addition to the fields of the parent
+func WithLogFields(ctx context.Context, fields ...zap.Field) context.Context {
+	return context.WithValue(ctx, loggerKey{}, GetLogger(ctx).With(fields...))
+}
@@ -23,3 +23,24 @@ func MapErr[I any, O any](ins []I, f func(I) (O, error)) ([]O, error) {
 
 	return out, nil
 }
+
+func Contains[T comparable](ins []T, v T) bool {
+	for _, in := range ins {
+		if in == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func Filter[T any](ins []T, f func(T) bool) []T {
+	out := make([]T, 0, len(ins))
+	for _, in := range ins {
+		if f(in) {
+			out = append(out, in)
+		}
+	}
+
+	return out
+}
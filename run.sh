@@ -22,10 +22,11 @@ TACO_ENABLE_QUERY_DEBUG_LOG=true \
   GOOGLE_APPLICATION_CREDENTIALS=/Users/kimtkyeom/.firebase/service_account.json \
   TACO_NOTIFICATION_TOPIC_URI="sqs.ap-northeast-2.amazonaws.com/069049357473/test-sqs" \
   TACO_TAXICALL_TOPIC_URI="sqs.ap-northeast-2.amazonaws.com/069049357473/test-sqs-taxicall" \
-  TACO_NOTIFICATION_OUTBOX_EVENT_URIS="TaxiCallNotification/User,TaxiCallNotification/Driver" \
+  TACO_NOTIFICATION_OUTBOX_EVENT_URIS="TaxiCallNotification/User,TaxiCallNotification/Driver,TaxiCallNotification/DriverBulk" \
   TACO_NOTIFICATION_OUTBOX_POLL_INTERVAL="200ms" \
   TACO_NOTIFICATION_OUTBOX_MAX_MESSAGES=50 \
   TACO_TAXICALL_OUTBOX_EVENT_URIS="TaxiCall/Process" \
   TACO_TAXICALL_OUTBOX_POLL_INTERVAL="200ms" \
   TACO_TAXICALL_OUTBOX_MAX_MESSAGES=50 \
+  TACO_FILE_UPLOAD_BUCKET_URI="s3://taco-test-driver-document?region=ap-northeast-2&awssdk=v2" \
   go run go/cmd/server/main.go
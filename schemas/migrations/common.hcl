@@ -7,3 +7,13 @@ enum "app_os" {
     "WEB",
   ]
 }
+
+enum "language" {
+  schema = schema.taco
+
+  values = [
+    "ko",
+    "en",
+    "zh",
+  ]
+}
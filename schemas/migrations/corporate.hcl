@@ -0,0 +1,205 @@
+table "corporate" {
+  schema = schema.taco
+
+  column "id" {
+    type = uuid
+    null = false
+  }
+
+  column "name" {
+    type = text
+    null = false
+  }
+
+  column "billing_email" {
+    type = text
+    null = false
+  }
+
+  column "create_time" {
+    type = timestamp
+    null = false
+  }
+
+  column "update_time" {
+    type = timestamp
+    null = false
+  }
+
+  primary_key {
+    columns = [
+      column.id,
+    ]
+  }
+}
+
+table "corporate_member" {
+  schema = schema.taco
+
+  column "corporate_id" {
+    type = uuid
+    null = false
+  }
+
+  column "user_id" {
+    type = uuid
+    null = false
+  }
+
+  column "create_time" {
+    type = timestamp
+    null = false
+  }
+
+  primary_key {
+    columns = [
+      column.corporate_id,
+      column.user_id,
+    ]
+  }
+
+  index "corporate_member_user_id_idx" {
+    unique = false
+    type = HASH
+    columns = [
+      column.user_id,
+    ]
+  }
+
+  foreign_key "corporate_member_corporate_id_fk" {
+    columns = [
+      column.corporate_id,
+    ]
+    ref_columns = [
+      table.corporate.column.id,
+    ]
+    on_delete = CASCADE
+    on_update = NO_ACTION
+  }
+
+  foreign_key "corporate_member_user_id_fk" {
+    columns = [
+      column.user_id,
+    ]
+    ref_columns = [
+      table.user.column.id,
+    ]
+    on_delete = CASCADE
+    on_update = NO_ACTION
+  }
+}
+
+table "corporate_policy" {
+  schema = schema.taco
+
+  column "corporate_id" {
+    type = uuid
+    null = false
+  }
+
+  column "start_hour" {
+    type = int
+    null = false
+    comment = "KST, inclusive"
+  }
+
+  column "end_hour" {
+    type = int
+    null = false
+    comment = "KST, exclusive. start_hour > end_hour means overnight window"
+  }
+
+  column "regions" {
+    type = sql("text[]")
+    null = false
+    comment = "eg. 서울, 서울 강남구. Empty means every region"
+  }
+
+  column "max_price_per_ride" {
+    type = int
+    null = false
+    comment = "0 means no limit"
+  }
+
+  column "update_time" {
+    type = timestamp
+    null = false
+  }
+
+  primary_key {
+    columns = [
+      column.corporate_id,
+    ]
+  }
+
+  foreign_key "corporate_policy_corporate_id_fk" {
+    columns = [
+      column.corporate_id,
+    ]
+    ref_columns = [
+      table.corporate.column.id,
+    ]
+    on_delete = CASCADE
+    on_update = NO_ACTION
+  }
+}
+
+table "corporate_invoice" {
+  schema = schema.taco
+
+  column "id" {
+    type = uuid
+    null = false
+  }
+
+  column "corporate_id" {
+    type = uuid
+    null = false
+  }
+
+  column "billing_month" {
+    type = text
+    null = false
+    comment = "eg. 2022-10"
+  }
+
+  column "ride_count" {
+    type = int
+    null = false
+  }
+
+  column "total_price" {
+    type = int
+    null = false
+  }
+
+  column "create_time" {
+    type = timestamp
+    null = false
+  }
+
+  primary_key {
+    columns = [
+      column.id,
+    ]
+  }
+
+  index "corporate_invoice_corporate_id_billing_month_uidx" {
+    unique = true
+    columns = [
+      column.corporate_id,
+      column.billing_month,
+    ]
+  }
+
+  foreign_key "corporate_invoice_corporate_id_fk" {
+    columns = [
+      column.corporate_id,
+    ]
+    ref_columns = [
+      table.corporate.column.id,
+    ]
+    on_delete = CASCADE
+    on_update = NO_ACTION
+  }
+}
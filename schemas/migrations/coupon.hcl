@@ -0,0 +1,197 @@
+enum "coupon_discount_type" {
+  schema = schema.taco
+
+  values = [
+    "FIXED",
+    "PERCENTAGE",
+  ]
+}
+
+enum "coupon_usage_state" {
+  schema = schema.taco
+
+  values = [
+    "RESERVED",
+    "USED",
+    "RELEASED",
+  ]
+}
+
+table "coupon" {
+  schema = schema.taco
+
+  column "id" {
+    type = uuid
+    null = false
+  }
+
+  column "code" {
+    type = text
+    null = false
+  }
+
+  column "name" {
+    type = text
+    null = false
+  }
+
+  column "discount_type" {
+    type = enum.coupon_discount_type
+    null = false
+  }
+
+  column "discount_amount" {
+    type = int
+    null = false
+    comment = "FIXED: 할인 금액, PERCENTAGE: 할인율 (%)"
+  }
+
+  column "max_discount_price" {
+    type = int
+    null = false
+    comment = "0 means no limit"
+  }
+
+  column "usage_limit" {
+    type = int
+    null = false
+    comment = "0 means no limit"
+  }
+
+  column "usage_count" {
+    type = int
+    null = false
+  }
+
+  column "per_user_limit" {
+    type = int
+    null = false
+    comment = "0 means no limit"
+  }
+
+  column "user_id" {
+    type = uuid
+    null = true
+    comment = "If set, only the user can use the coupon"
+  }
+
+  column "regions" {
+    type = sql("text[]")
+    null = false
+    comment = "eg. 서울, 서울 강남구. Empty means every region"
+  }
+
+  column "start_time" {
+    type = timestamp
+    null = false
+  }
+
+  column "expire_time" {
+    type = timestamp
+    null = false
+  }
+
+  column "create_time" {
+    type = timestamp
+    null = false
+  }
+
+  column "update_time" {
+    type = timestamp
+    null = false
+  }
+
+  primary_key {
+    columns = [
+      column.id,
+    ]
+  }
+
+  index "coupon_code_idx" {
+    unique = true
+    columns = [
+      column.code,
+    ]
+  }
+}
+
+table "coupon_usage" {
+  schema = schema.taco
+
+  column "taxi_call_request_id" {
+    type = uuid
+    null = false
+  }
+
+  column "coupon_id" {
+    type = uuid
+    null = false
+  }
+
+  column "user_id" {
+    type = uuid
+    null = false
+  }
+
+  column "coupon_usage_state" {
+    type = enum.coupon_usage_state
+    null = false
+  }
+
+  column "discount_price" {
+    type = int
+    null = false
+  }
+
+  column "create_time" {
+    type = timestamp
+    null = false
+  }
+
+  column "update_time" {
+    type = timestamp
+    null = false
+  }
+
+  primary_key {
+    columns = [
+      column.taxi_call_request_id,
+    ]
+  }
+
+  index "coupon_usage_coupon_id_user_id_idx" {
+    unique = false
+    columns = [
+      column.coupon_id,
+      column.user_id,
+    ]
+  }
+
+  foreign_key "coupon_usage_taxi_call_request_id_fk" {
+    columns = [
+      column.taxi_call_request_id,
+    ]
+
+    ref_columns = [
+      table.taxi_call_request.column.id,
+    ]
+
+    on_delete = CASCADE
+
+    on_update = NO_ACTION
+  }
+
+  foreign_key "coupon_usage_coupon_id_fk" {
+    columns = [
+      column.coupon_id,
+    ]
+
+    ref_columns = [
+      table.coupon.column.id,
+    ]
+
+    on_delete = CASCADE
+
+    on_update = NO_ACTION
+  }
+}
@@ -59,6 +59,13 @@ table "driver" {
     null = false
   }
 
+  column "language" {
+    type = enum.language
+    null = false
+    default = "ko"
+    comment = "Preferred language for notifications"
+  }
+
   column "active" {
     type = boolean
     null = false
@@ -168,3 +175,275 @@ table "driver_settlement_account" {
   }
 }
 
+
+enum "driver_document_type" {
+  schema = schema.taco
+  values = [
+    "DRIVER_LICENSE",
+    "VEHICLE_REGISTRATION",
+    "INSURANCE",
+  ]
+}
+
+enum "driver_document_state" {
+  schema = schema.taco
+  values = [
+    "PENDING",
+    "APPROVED",
+    "REJECTED",
+  ]
+}
+
+table "driver_document" {
+  schema = schema.taco
+
+  column "driver_id" {
+    type = uuid
+    null = false
+  }
+
+  column "document_type" {
+    type = enum.driver_document_type
+    null = false
+  }
+
+  column "file_key" {
+    type = text
+    null = false
+    comment = "Object key of uploaded document image"
+  }
+
+  column "driver_document_state" {
+    type = enum.driver_document_state
+    null = false
+  }
+
+  column "reject_reason" {
+    type = text
+    null = false
+  }
+
+  column "expire_time" {
+    type = timestamp
+    null = false
+    comment = "Expire date of document, set by backoffice on approval"
+  }
+
+  column "review_time" {
+    type = timestamp
+    null = false
+  }
+
+  column "create_time" {
+    type = timestamp
+    null = false
+  }
+
+  column "update_time" {
+    type = timestamp
+    null = false
+  }
+
+  primary_key {
+    columns = [
+      column.driver_id,
+      column.document_type,
+    ]
+  }
+
+  index "driver_document_state_update_time_idx" {
+    unique = false
+    columns = [
+      column.driver_document_state,
+      column.update_time,
+    ]
+  }
+
+  foreign_key "driver_document_driver_id_fk" {
+    columns = [
+      column.driver_id,
+    ]
+
+    ref_columns = [
+      table.driver.column.id,
+    ]
+
+    on_delete = CASCADE
+
+    on_update = NO_ACTION
+  }
+}
+
+enum "vehicle_class" {
+  schema = schema.taco
+  values = [
+    "STANDARD",
+    "DELUXE",
+    "LARGE",
+  ]
+}
+
+table "vehicle" {
+  schema = schema.taco
+
+  column "driver_id" {
+    type = uuid
+    null = false
+  }
+
+  column "plate_number" {
+    type = text
+    null = false
+    comment = "차량 번호 (eg. 서울12가3456)"
+  }
+
+  column "model" {
+    type = text
+    null = false
+  }
+
+  column "color" {
+    type = text
+    null = false
+  }
+
+  column "capacity" {
+    type = int
+    null = false
+    comment = "Number of passenger seats"
+  }
+
+  column "vehicle_class" {
+    type = enum.vehicle_class
+    null = false
+  }
+
+  column "verified" {
+    type = boolean
+    null = false
+    comment = "Is vehicle verified by backoffice (reset when driver updates vehicle)"
+  }
+
+  column "verify_time" {
+    type = timestamp
+    null = false
+  }
+
+  column "create_time" {
+    type = timestamp
+    null = false
+  }
+
+  column "update_time" {
+    type = timestamp
+    null = false
+  }
+
+  primary_key {
+    columns = [
+      column.driver_id,
+    ]
+  }
+
+  index "vehicle_plate_number_uidx" {
+    unique = true
+    columns = [
+      column.plate_number,
+    ]
+  }
+
+  foreign_key "vehicle_driver_id_fk" {
+    columns = [
+      column.driver_id,
+    ]
+
+    ref_columns = [
+      table.driver.column.id,
+    ]
+
+    on_delete = CASCADE
+
+    on_update = NO_ACTION
+  }
+}
+
+enum "driver_duty_end_reason" {
+  schema = schema.taco
+  values = [
+    "MANUAL",
+    "TIMEOUT",
+    "FORCED",
+  ]
+}
+
+table "driver_duty_session" {
+  schema = schema.taco
+
+  column "id" {
+    type = uuid
+    null = false
+  }
+
+  column "driver_id" {
+    type = uuid
+    null = false
+  }
+
+  column "start_time" {
+    type = timestamp
+    null = false
+  }
+
+  column "last_active_time" {
+    type = timestamp
+    null = false
+    comment = "Last location update time of driver during the session"
+  }
+
+  column "end_time" {
+    type = timestamp
+    null = true
+    comment = "Null while driver is on duty"
+  }
+
+  column "end_reason" {
+    type = enum.driver_duty_end_reason
+    null = true
+  }
+
+  primary_key {
+    columns = [
+      column.id,
+    ]
+  }
+
+  index "driver_duty_session_driver_id_start_time_idx" {
+    unique = false
+    columns = [
+      column.driver_id,
+      column.start_time,
+    ]
+  }
+
+  index "driver_duty_session_active_driver_id_uidx" {
+    unique = true
+    columns = [
+      column.driver_id,
+    ]
+    where = "end_time IS NULL"
+  }
+
+  foreign_key "driver_duty_session_driver_id_fk" {
+    columns = [
+      column.driver_id,
+    ]
+
+    ref_columns = [
+      table.driver.column.id,
+    ]
+
+    on_delete = CASCADE
+
+    on_update = NO_ACTION
+  }
+}
@@ -21,6 +21,24 @@ table "event" {
     null = false
   }
 
+  column "trace_parent" {
+    type = text
+    null = true
+    comment = "W3C trace context of the span which created the event"
+  }
+
+  column "trace_state" {
+    type = text
+    null = true
+  }
+
+  column "publish_failure_count" {
+    type = int
+    null = false
+    default = 0
+    comment = "Event is moved to dead letter after repeated publish failures"
+  }
+
   column "create_time" {
     type = timestamp
     null = false
@@ -40,3 +58,162 @@ table "event" {
     ]
   }
 }
+
+table "event_queue" {
+  schema = schema.taco
+
+  column "message_id" {
+    type = uuid
+    null = false
+  }
+
+  column "topic" {
+    type = text
+    null = false
+  }
+
+  column "event_uri" {
+    type = text
+    null = false
+  }
+
+  column "payload" {
+    type = jsonb
+    null = false
+  }
+
+  column "retry_count" {
+    type = int
+    null = false
+  }
+
+  column "max_retry_count" {
+    type = int
+    null = false
+    default = 0
+  }
+
+  column "receive_count" {
+    type = int
+    null = false
+  }
+
+  column "first_attempt_time" {
+    type = timestamp
+    null = true
+  }
+
+  column "trace_parent" {
+    type = text
+    null = true
+  }
+
+  column "trace_state" {
+    type = text
+    null = true
+  }
+
+  column "visible_time" {
+    type = timestamp
+    null = false
+    comment = "Message is not received until visible time (delay & visibility timeout)"
+  }
+
+  column "create_time" {
+    type = timestamp
+    null = false
+  }
+
+  primary_key {
+    columns = [
+      column.message_id,
+    ]
+  }
+
+  index "event_queue_topic_visible_time_idx" {
+    unique = false
+    columns = [
+      column.topic,
+      column.visible_time,
+    ]
+  }
+}
+
+table "dead_letter" {
+  schema = schema.taco
+
+  column "id" {
+    type = uuid
+    null = false
+  }
+
+  column "topic" {
+    type = text
+    null = false
+  }
+
+  column "event_uri" {
+    type = text
+    null = false
+  }
+
+  column "payload" {
+    type = jsonb
+    null = false
+  }
+
+  column "retry_count" {
+    type = int
+    null = false
+  }
+
+  column "last_error" {
+    type = text
+    null = false
+  }
+
+  column "first_attempt_time" {
+    type = timestamp
+    null = false
+  }
+
+  column "last_attempt_time" {
+    type = timestamp
+    null = false
+  }
+
+  column "max_retry_count" {
+    type = int
+    null = false
+    default = 0
+  }
+
+  column "trace_parent" {
+    type = text
+    null = true
+  }
+
+  column "trace_state" {
+    type = text
+    null = true
+  }
+
+  column "create_time" {
+    type = timestamp
+    null = false
+  }
+
+  primary_key {
+    columns = [
+      column.id,
+    ]
+  }
+
+  index "dead_letter_topic_create_time_idx" {
+    unique = false
+    columns = [
+      column.topic,
+      column.create_time,
+    ]
+  }
+}
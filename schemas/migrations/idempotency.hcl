@@ -0,0 +1,68 @@
+table "idempotency_key" {
+  schema = schema.taco
+
+  column "principal_id" {
+    type = text
+    null = false
+    comment = "User id or driver id"
+  }
+
+  column "idempotency_key" {
+    type = text
+    null = false
+    comment = "Idempotency-Key header from client"
+  }
+
+  column "route" {
+    type = text
+    null = false
+    comment = "Request method and path pattern (eg. POST /taxicall)"
+  }
+
+  column "request_hash" {
+    type = text
+    null = false
+    comment = "Hex encoded sha256 of request body"
+  }
+
+  column "response_status" {
+    type = int
+    null = false
+    comment = "0 means the request is still in progress"
+  }
+
+  column "response_body" {
+    type = bytea
+    null = true
+  }
+
+  column "create_time" {
+    type = timestamp
+    null = false
+  }
+
+  column "expire_time" {
+    type = timestamp
+    null = false
+  }
+
+  column "lease_expire_time" {
+    type = timestamp
+    null = false
+    comment = "In progress request not completed until the lease expires is taken over by retried one"
+  }
+
+  primary_key {
+    columns = [
+      column.principal_id,
+      column.idempotency_key,
+    ]
+  }
+
+  index "idempotency_key_expire_time_idx" {
+    unique = false
+    columns = [
+      column.expire_time,
+    ]
+  }
+}
@@ -1,3 +1,12 @@
+enum "notification_channel" {
+  schema = schema.taco
+
+  values = [
+    "PUSH",
+    "SMS",
+  ]
+}
+
 table "push_token" {
   schema = schema.taco
 
@@ -27,3 +36,108 @@ table "push_token" {
     ]
   }
 }
+
+table "notification_preference" {
+  schema = schema.taco
+
+  column "principal_id" {
+    type = uuid
+    null = false
+    comment = "User id or driver id"
+  }
+
+  column "progress_enabled" {
+    type = boolean
+    null = false
+    comment = "Receive taxi call dispatch progress notifications"
+  }
+
+  column "progress_channel" {
+    type = enum.notification_channel
+    null = false
+    default = "PUSH"
+  }
+
+  column "marketing_agreed" {
+    type = boolean
+    null = false
+  }
+
+  column "marketing_agree_time" {
+    type = timestamp
+    null = true
+  }
+
+  column "marketing_channel" {
+    type = enum.notification_channel
+    null = false
+    default = "PUSH"
+  }
+
+  column "quiet_hours_enabled" {
+    type = boolean
+    null = false
+  }
+
+  column "quiet_start_hour" {
+    type = int
+    null = false
+    comment = "Start hour of quiet hours in KST (inclusive)"
+  }
+
+  column "quiet_end_hour" {
+    type = int
+    null = false
+    comment = "End hour of quiet hours in KST (exclusive)"
+  }
+
+  column "update_time" {
+    type = timestamp
+    null = false
+  }
+
+  primary_key {
+    columns = [
+      column.principal_id,
+    ]
+  }
+}
+
+table "marketing_consent" {
+  schema = schema.taco
+
+  column "id" {
+    type = uuid
+    null = false
+  }
+
+  column "principal_id" {
+    type = uuid
+    null = false
+  }
+
+  column "agreed" {
+    type = boolean
+    null = false
+    comment = "Opt-in or opt-out"
+  }
+
+  column "create_time" {
+    type = timestamp
+    null = false
+  }
+
+  primary_key {
+    columns = [
+      column.id,
+    ]
+  }
+
+  index "marketing_consent_principal_id_create_time_idx" {
+    unique = false
+    columns = [
+      column.principal_id,
+      column.create_time,
+    ]
+  }
+}
@@ -51,6 +51,12 @@ table "taxi_call_request" {
     comment = "User payment id / company / redacted card number"
   }
 
+  column "corporate_id" {
+    type = uuid
+    null = true
+    comment = "Billed to corporate instead of user payment if set"
+  }
+
   // price (requested)
   column "request_base_price" {
     type = int
@@ -78,6 +84,19 @@ table "taxi_call_request" {
     null = true
   }
 
+  column "coupon_discount_price" {
+    type = int
+    null = false
+    default = 0
+  }
+
+  column "version" {
+    type = int
+    null = false
+    default = 0
+    comment = "Optimistic lock version"
+  }
+
   column "create_time" {
     type = timestamp
     null = false
@@ -110,6 +129,14 @@ table "taxi_call_request" {
     ]
   }
 
+  index "taxi_call_request_corporate_id_idx" {
+    unique = false
+    type = HASH
+    columns = [
+      column.corporate_id,
+    ]
+  }
+
   index "create_time_brin_idx" {
     unique = false
     type = BRIN
@@ -142,6 +169,13 @@ table "taxi_call_ticket" {
     null = false
   }
 
+  column "version" {
+    type = int
+    null = false
+    default = 0
+    comment = "Optimistic lock version"
+  }
+
   column "create_time" {
     type = timestamp
     null = false
@@ -326,3 +360,110 @@ table "driver_taxi_call_settlement" {
     on_update = NO_ACTION
   }
 }
+
+enum "taxi_call_actor_type" {
+  schema = schema.taco
+
+  values = [
+    "USER",
+    "DRIVER",
+    "SYSTEM",
+  ]
+}
+
+table "taxi_call_history" {
+  schema = schema.taco
+
+  column "id" {
+    type = uuid
+    null = false
+  }
+
+  column "taxi_call_request_id" {
+    type = uuid
+    null = false
+  }
+
+  column "actor_type" {
+    type = enum.taxi_call_actor_type
+    null = false
+  }
+
+  column "actor_id" {
+    type = uuid
+    null = true
+  }
+
+  column "from_state" {
+    type = enum.taxi_call_state
+    null = true
+    comment = "Null on taxi call request creation"
+  }
+
+  column "to_state" {
+    type = enum.taxi_call_state
+    null = false
+  }
+
+  column "ticket_id" {
+    type = uuid
+    null = true
+  }
+
+  column "base_price" {
+    type = int
+    null = false
+  }
+
+  column "additional_price" {
+    type = int
+    null = false
+  }
+
+  column "location" {
+    type = jsonb
+    null = true
+    comment = "Location of the actor at the transition if known"
+  }
+
+  column "create_time" {
+    type = timestamp
+    null = false
+  }
+
+  primary_key {
+    columns = [
+      column.id,
+    ]
+  }
+
+  index "taxi_call_history_taxi_call_request_id_idx" {
+    unique = false
+    type = HASH
+    columns = [
+      column.taxi_call_request_id,
+    ]
+  }
+
+  index "taxi_call_history_create_time_brin_idx" {
+    unique = false
+    type = BRIN
+    columns = [
+      column.create_time,
+    ]
+  }
+
+  foreign_key "history_taxi_call_request_id_fk" {
+    columns = [
+      column.taxi_call_request_id,
+    ]
+
+    ref_columns = [
+      table.taxi_call_request.column.id,
+    ]
+
+    on_delete = CASCADE
+
+    on_update = NO_ACTION
+  }
+}
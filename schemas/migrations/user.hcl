@@ -46,12 +46,25 @@ table "user" {
     null = false
   }
 
+  column "language" {
+    type = enum.language
+    null = false
+    default = "ko"
+    comment = "Preferred language for notifications"
+  }
+
   column "user_unique_key" {
     type = text
     comment = "User unique key from authentication service (eg. IamPort)"
     null = false
   }
 
+  column "referral_code" {
+    type = text
+    null = false
+    comment = "Existing users are backfilled by migration.sh before apply"
+  }
+
   column "create_time" {
     type = timestamp
     null = false
@@ -79,6 +92,13 @@ table "user" {
       column.user_unique_key,
     ]
   }
+
+  index "user_referral_code_uidx" {
+    unique = true
+    columns = [
+      column.referral_code,
+    ]
+  }
 }
 
 table "user_payment" {
@@ -206,3 +226,84 @@ table "user_default_payment" {
     on_update = NO_ACTION
   }
 }
+
+enum "referral_state" {
+  schema = schema.taco
+
+  values = [
+    "PENDING",
+    "REWARDED",
+    "REJECTED",
+  ]
+}
+
+table "user_referral" {
+  schema = schema.taco
+
+  column "referee_user_id" {
+    type = uuid
+    null = false
+  }
+
+  column "referrer_user_id" {
+    type = uuid
+    null = false
+  }
+
+  column "referral_state" {
+    type = enum.referral_state
+    null = false
+  }
+
+  column "reject_reason" {
+    type = text
+    null = false
+    comment = "Fraud check failure reason (eg. same phone prefix, same payment card)"
+  }
+
+  column "create_time" {
+    type = timestamp
+    null = false
+  }
+
+  column "update_time" {
+    type = timestamp
+    null = false
+  }
+
+  primary_key {
+    columns = [
+      column.referee_user_id,
+    ]
+  }
+
+  index "user_referral_referrer_user_id_idx" {
+    unique = false
+    type = HASH
+    columns = [
+      column.referrer_user_id,
+    ]
+  }
+
+  foreign_key "user_referral_referee_user_id_fk" {
+    columns = [
+      column.referee_user_id,
+    ]
+    ref_columns = [
+      table.user.column.id,
+    ]
+    on_delete = CASCADE
+    on_update = NO_ACTION
+  }
+
+  foreign_key "user_referral_referrer_user_id_fk" {
+    columns = [
+      column.referrer_user_id,
+    ]
+    ref_columns = [
+      table.user.column.id,
+    ]
+    on_delete = CASCADE
+    on_update = NO_ACTION
+  }
+}